@@ -1,10 +1,15 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"math/rand"
+	"net/http"
+	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -20,100 +25,212 @@ func main() {
 Options:
   --workload	N	run benchmark with N workloads (default: 3)
   --ip	IP	specify target IP Address (default: 127.0.0.1)
+  --target	URL	base URL of the webapp, e.g. http://127.0.0.1:8080 (overrides --ip)
+  --validate-only		stop after the validation phase
+  --csrf		send the csrf_token of the vote form, fetched once per client (again after a 403)
+  --report	FILE	write the run report to FILE as JSON
+  --report-requests	FILE	write every request to FILE as JSON Lines
+  --dsn	DSN	MySQL DSN to read users and candidates from (default: ishocon:ishocon@/ishocon2)
+  --users	FILE	read users from a CSV or JSONL dump instead of MySQL
+  --candidates	FILE	read candidates from a CSV or JSONL dump instead of MySQL
+  --seed	N	random seed to reproduce a run (default: current time)
+  --profile	FILE	load phase durations and scoring weights from a JSON file (.json, YAML is not supported)
+  --ready-timeout	D	time to wait for GET /readyz to return 200 (default: 30s)
+  --initialize-timeout	D	time limit of GET /initialize (default: 10s)
+  --vote-duration	D	duration of the voting phase (default: 45s)
+  --result-duration	D	duration of the result viewing phase (default: 15s)
+  --score-get	N	points per successful GET (default: 2)
+  --score-post	N	points per successful POST (default: 1)
+  --score-failure	N	points deducted per failed GET (default: 100)
 	--debug		debug mode (DO NOT USE)`)
 	}
 
 	var (
 		workload = flag.Int("workload", 3, "")
 		ip       = flag.String("ip", "127.0.0.1", "")
+		target   = flag.String("target", "", "")
+		valOnly  = flag.Bool("validate-only", false, "")
 		debug    = flag.Bool("debug", false, "")
+		rep      = flag.String("report", "", "")
+		repReqs  = flag.String("report-requests", "", "")
+		dsn      = flag.String("dsn", "ishocon:ishocon@/ishocon2", "")
+		users    = flag.String("users", "", "")
+		cands    = flag.String("candidates", "", "")
+		seedFlag = flag.Int64("seed", time.Now().UnixNano(), "")
+		prof     = flag.String("profile", "", "")
+		flags    = defaultProfile()
 	)
+	flag.DurationVar(&flags.ReadyTimeout.Duration, "ready-timeout", flags.ReadyTimeout.Duration, "")
+	flag.DurationVar(&flags.InitializeTimeout.Duration, "initialize-timeout", flags.InitializeTimeout.Duration, "")
+	flag.DurationVar(&flags.VoteDuration.Duration, "vote-duration", flags.VoteDuration.Duration, "")
+	flag.DurationVar(&flags.ResultDuration.Duration, "result-duration", flags.ResultDuration.Duration, "")
+	flag.IntVar(&flags.Score.GetSuccess, "score-get", flags.Score.GetSuccess, "")
+	flag.IntVar(&flags.Score.PostSuccess, "score-post", flags.Score.PostSuccess, "")
+	flag.IntVar(&flags.Score.Failure, "score-failure", flags.Score.Failure, "")
+	flag.BoolVar(&csrf, "csrf", false, "")
 	flag.Parse()
+
+	p, err := loadProfile(*prof, flag.CommandLine, flags)
+	if err != nil {
+		log.Fatalf("Failed to load profile: %s", err)
+	}
+	profile = p
+	seed = *seedFlag
+	log.Print("seed: " + strconv.FormatInt(seed, 10))
 	host = "https://" + *ip
+	if *target != "" {
+		host = strings.TrimSuffix(*target, "/")
+	}
 	if *debug {
 		host = "http://127.0.0.1:8080"
 	}
 
+	var f FixtureProvider
+	if (*users == "") != (*cands == "") {
+		log.Fatal("Failed to load fixture: specify both --users and --candidates to read dumps")
+	} else if *users != "" {
+		f, err = NewFileFixture(*users, *cands)
+	} else {
+		f, err = NewMySQLFixture(*dsn)
+	}
+	if err == nil {
+		err = setupFixture(f)
+	}
+	if err != nil {
+		log.Fatalf("Failed to load fixture: %s", err)
+	}
+
+	setupReport(*rep, *repReqs, host, *workload)
 	createClients(*workload * 5)
-	startBenchmark(*workload)
+	if *valOnly {
+		err = runValidation()
+	} else {
+		err = startBenchmark(*workload)
+	}
+	if err != nil {
+		printFailure(err)
+		os.Exit(1)
+	}
+	if *valOnly {
+		printPass()
+	}
 }
 
-func startBenchmark(workload int) {
-	getInitialize()
+// 初期化と期日前投票の確認。--validate-only の場合はここで終わる
+func runValidation() error {
+	startPhase("ready")
+	if err := waitReady(); err != nil {
+		return err
+	}
+	startPhase("initialize")
+	if err := getInitialize(); err != nil {
+		return err
+	}
 	log.Print("期日前投票を開始します")
-	validateInitialize()
+	startPhase("validation")
+	if err := validateInitialize(newRand(0)); err != nil {
+		return err
+	}
+	passValidation()
 	log.Print("期日前投票が終了しました")
+	return nil
+}
+
+// Validate runs the validation phase against the webapp at baseURL, such as an httptest.Server,
+// sending every request with client. The fixture must be set with setupFixture before.
+func Validate(baseURL string, client *http.Client) error {
+	host = strings.TrimSuffix(baseURL, "/")
+	setClients([]http.Client{*client})
+	return runValidation()
+}
+
+func startBenchmark(workload int) error {
+	if err := runValidation(); err != nil {
+		return err
+	}
 	log.Print("投票を開始します  Workload: " + strconv.Itoa(workload))
-	voteTime := time.Now().Add(45 * time.Second)
+	startPhase("vote")
+	voteTime := time.Now().Add(profile.VoteDuration.Duration)
 	wg := new(sync.WaitGroup)
 	m := new(sync.Mutex)
 	for i := 0; i < workload+1; i++ {
 		wg.Add(1)
 		if i%5 == 0 {
-			go loopInvalidVoteScenario(wg, m, voteTime)
+			go loopVoteScenario(invalidVoteScenario, newRand(int64(1000+i)), wg, m, voteTime)
 		} else {
-			go loopVoteScenario(wg, m, voteTime)
+			go loopVoteScenario(voteScenario, newRand(int64(1000+i)), wg, m, voteTime)
 		}
 	}
 	wg.Wait()
+	if err := getRunError(); err != nil {
+		return err
+	}
 	log.Print("投票が終了しました")
-	finishTime := time.Now().Add(15 * time.Second)
+	finishTime := time.Now().Add(profile.ResultDuration.Duration)
 	log.Print("投票者が結果を確認しています")
+	startPhase("result")
 	for i := 0; i < workload+2; i++ {
 		wg.Add(1)
 		if i%4 == 0 || i%4 == 3 {
-			go loopIndexScenario(wg, m, finishTime)
+			go loopScenario(indexScenario, newRand(int64(2000+i)), wg, m, finishTime)
 		} else if i%4 == 1 {
-			go loopCandidateScenario(wg, m, finishTime)
+			go loopScenario(candidateScenario, newRand(int64(2000+i)), wg, m, finishTime)
 		} else {
-			go loopPoliticalPartyScenario(wg, m, finishTime)
+			go loopScenario(politicalPartyScenario, newRand(int64(2000+i)), wg, m, finishTime)
 		}
 	}
 	wg.Wait()
 	printScore()
+	return nil
 }
 
-func loopInvalidVoteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
-	for {
-		if invalidVoteScenario(wg, m, finishTime) {
+// 投票シナリオは失敗するとその時点で負荷走行を止める
+func loopVoteScenario(scenario func(*rand.Rand, *sync.Mutex, time.Time) (bool, error), r *rand.Rand, wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
+	defer wg.Done()
+	for getRunError() == nil {
+		finished, err := scenario(r, m, finishTime)
+		if err != nil {
+			setRunError(err)
 			break
 		}
-	}
-}
-
-func loopVoteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
-	for {
-		if voteScenario(wg, m, finishTime) {
+		if finished {
 			break
 		}
 	}
 }
 
-func loopIndexScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
+func loopScenario(scenario func(*rand.Rand, *sync.Mutex, time.Time) bool, r *rand.Rand, wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
+	defer wg.Done()
 	for {
-		if indexScenario(wg, m, finishTime) {
+		if scenario(r, m, finishTime) {
 			break
 		}
 	}
 }
 
-func loopCandidateScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
-	for {
-		if candidateScenario(wg, m, finishTime) {
-			break
-		}
-	}
+func printScore() {
+	log.Print("投票者の感心がなくなりました")
+	log.Print("{\"score\": " + strconv.Itoa(totalScore) + ", \"success\": " + strconv.Itoa(totalResp[true]) + ", \"failure\": " + strconv.Itoa(totalResp[false]) + "}")
+	writeReport()
 }
 
-func loopPoliticalPartyScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) {
-	for {
-		if politicalPartyScenario(wg, m, finishTime) {
-			break
-		}
-	}
+func printPass() {
+	log.Print("{\"pass\": true}")
+	writeReport()
 }
 
-func printScore() {
-	log.Print("投票者の感心がなくなりました")
-	log.Print("{\"score\": " + strconv.Itoa(totalScore) + ", \"success\": " + strconv.Itoa(totalResp[true]) + ", \"failure\": " + strconv.Itoa(totalResp[false]) + "}")
+func printFailure(err error) {
+	var errs Failures
+	errs.add(err)
+	for _, e := range errs {
+		log.Print(e.Error())
+	}
+	failReport(errs)
+	b, _ := json.Marshal(map[string]interface{}{
+		"pass":     false,
+		"phase":    errs[0].Phase,
+		"failures": errs,
+	})
+	log.Print(string(b))
+	writeReport()
 }
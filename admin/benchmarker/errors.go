@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"sync"
+)
+
+// BenchmarkError is a failure detected by the benchmarker
+type BenchmarkError struct {
+	Phase    string `json:"phase"`
+	Endpoint string `json:"endpoint"`
+	Message  string `json:"message"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+}
+
+func (e *BenchmarkError) Error() string {
+	return e.Message + " at " + e.Endpoint
+}
+
+// 現在のフェーズでの失敗を作る
+func newError(endpoint string, message string, expected string, actual string) *BenchmarkError {
+	return &BenchmarkError{
+		Phase:    currentPhase(),
+		Endpoint: endpoint,
+		Message:  message,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// Failures is a list of failures detected in a phase
+type Failures []*BenchmarkError
+
+func (f Failures) Error() string {
+	msgs := make([]string, len(f))
+	for i, e := range f {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (f *Failures) add(err error) {
+	switch e := err.(type) {
+	case nil:
+	case *BenchmarkError:
+		*f = append(*f, e)
+	case Failures:
+		*f = append(*f, e...)
+	default:
+		*f = append(*f, newError("", e.Error(), "", ""))
+	}
+}
+
+// 失敗がなければ nil を返す
+func (f Failures) err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return f
+}
+
+// 負荷走行中の goroutine から最初の失敗を受け取る
+var (
+	runErr   error
+	runErrMu sync.Mutex
+)
+
+func setRunError(err error) {
+	runErrMu.Lock()
+	defer runErrMu.Unlock()
+	if runErr == nil {
+		runErr = err
+	}
+}
+
+func getRunError() error {
+	runErrMu.Lock()
+	defer runErrMu.Unlock()
+	return runErr
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// User is a voter registered in the users table
+type User struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	Mynumber string `json:"mynumber"`
+	Votes    int    `json:"votes"`
+}
+
+// FixtureProvider provides users and candidates known to the webapp
+type FixtureProvider interface {
+	// 重複しない size 人の投票者をランダムに選ぶ。票の無い投票者は選ばない。
+	// 選べる投票者が size 人に足りなければエラーを返す
+	RandomUsers(r *rand.Rand, size int) ([]User, error)
+	Candidates() ([]Candidate, error)
+}
+
+var fixture FixtureProvider
+
+// 候補者は数が少ないので起動時に読み込んでおく
+var (
+	candidates       []Candidate
+	candidatesByName = map[string]Candidate{}
+)
+
+func setupFixture(f FixtureProvider) error {
+	cs, err := f.Candidates()
+	if err != nil {
+		return err
+	}
+	if len(cs) == 0 {
+		return errors.New("no candidates in fixture")
+	}
+	fixture = f
+	candidates = cs
+	for _, c := range cs {
+		candidatesByName[c.Name] = c
+	}
+	return nil
+}
+
+// MySQLFixture reads fixtures from the database of the webapp
+type MySQLFixture struct {
+	db    *sql.DB
+	maxID int
+	// 票を持っている投票者の数
+	eligible int
+}
+
+// NewMySQLFixture connects to the database with dsn
+func NewMySQLFixture(dsn string) (*MySQLFixture, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	f := &MySQLFixture{db: db}
+	if err := db.QueryRow("SELECT IFNULL(MAX(id), 0) FROM users").Scan(&f.maxID); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if f.maxID == 0 {
+		db.Close()
+		return nil, errors.New("no users in database")
+	}
+	if err := db.QueryRow("SELECT COUNT(*) FROM users WHERE votes > 0").Scan(&f.eligible); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// RandomUsers implements FixtureProvider
+func (f *MySQLFixture) RandomUsers(r *rand.Rand, size int) ([]User, error) {
+	if size > f.eligible {
+		return nil, fmt.Errorf("users: %d users are needed, but the database has %d with votes", size, f.eligible)
+	}
+	// id は欠番や票の無い投票者もあるので、FileFixture と同じく size 人になるまで選び直す。
+	// 結果は選んだ順に並べるので、同じ seed なら同じ順になる
+	tried := map[int]bool{}
+	found := map[int]User{}
+	var order []int
+	for len(found) < size {
+		if len(tried) == f.maxID {
+			return nil, fmt.Errorf("users: only %d of %d users with votes are found", len(found), size)
+		}
+		var ids []interface{}
+		for len(ids) < size-len(found) && len(tried) < f.maxID {
+			id := getRand(r, 1, f.maxID)
+			if tried[id] {
+				continue
+			}
+			tried[id] = true
+			ids = append(ids, id)
+			order = append(order, id)
+		}
+		if err := f.findUsers(ids, found); err != nil {
+			return nil, err
+		}
+	}
+
+	users := make([]User, 0, size)
+	for _, id := range order {
+		if u, ok := found[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// findUsers adds the users with votes among ids to found
+func (f *MySQLFixture) findUsers(ids []interface{}, found map[int]User) error {
+	query := "SELECT id, name, address, mynumber, votes FROM users WHERE votes > 0 AND id IN (?" + strings.Repeat(",?", len(ids)-1) + ")"
+	rows, err := f.db.Query(query, ids...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Address, &u.Mynumber, &u.Votes); err != nil {
+			return err
+		}
+		found[u.ID] = u
+	}
+	return rows.Err()
+}
+
+// Candidates implements FixtureProvider
+func (f *MySQLFixture) Candidates() ([]Candidate, error) {
+	rows, err := f.db.Query("SELECT id, name, political_party, sex FROM candidates ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var cs []Candidate
+	for rows.Next() {
+		var c Candidate
+		if err := rows.Scan(&c.ID, &c.Name, &c.Party, &c.Sex); err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, rows.Err()
+}
+
+// Close closes the database
+func (f *MySQLFixture) Close() error {
+	return f.db.Close()
+}
+
+// FileFixture reads fixtures from CSV or JSONL dumps of the users and candidates tables
+type FileFixture struct {
+	users      []User
+	candidates []Candidate
+}
+
+// NewFileFixture loads dumps. The format is decided by the extension (.csv or .jsonl).
+// CSV columns are in the same order as the tables.
+func NewFileFixture(usersPath string, candidatesPath string) (*FileFixture, error) {
+	f := &FileFixture{}
+	err := readRecords(usersPath, func(rec []string) error {
+		if len(rec) < 5 {
+			return errors.New("users: too few columns")
+		}
+		id, err := strconv.Atoi(rec[0])
+		if err != nil {
+			return err
+		}
+		votes, err := strconv.Atoi(rec[4])
+		if err != nil {
+			return err
+		}
+		return f.addUser(User{ID: id, Name: rec[1], Address: rec[2], Mynumber: rec[3], Votes: votes})
+	}, func(line []byte) error {
+		var u User
+		if err := json.Unmarshal(line, &u); err != nil {
+			return err
+		}
+		return f.addUser(u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(f.users) == 0 {
+		return nil, errors.New("no users in " + usersPath)
+	}
+
+	err = readRecords(candidatesPath, func(rec []string) error {
+		if len(rec) < 4 {
+			return errors.New("candidates: too few columns")
+		}
+		f.candidates = append(f.candidates, Candidate{ID: rec[0], Name: rec[1], Party: rec[2], Sex: rec[3]})
+		return nil
+	}, func(line []byte) error {
+		var c struct {
+			ID             int    `json:"id"`
+			Name           string `json:"name"`
+			PoliticalParty string `json:"political_party"`
+			Sex            string `json:"sex"`
+		}
+		if err := json.Unmarshal(line, &c); err != nil {
+			return err
+		}
+		f.candidates = append(f.candidates, Candidate{ID: strconv.Itoa(c.ID), Name: c.Name, Party: c.PoliticalParty, Sex: c.Sex})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// 投票数は 1 から持っている票数までのランダムなので、票の無い投票者は使えない
+func (f *FileFixture) addUser(u User) error {
+	if u.Votes < 1 {
+		return fmt.Errorf("users: user %d has %d votes, at least 1 is needed", u.ID, u.Votes)
+	}
+	f.users = append(f.users, u)
+	return nil
+}
+
+// RandomUsers implements FixtureProvider
+func (f *FileFixture) RandomUsers(r *rand.Rand, size int) ([]User, error) {
+	if size > len(f.users) {
+		return nil, fmt.Errorf("users: %d users are needed, but the dump has %d", size, len(f.users))
+	}
+	// 同じ投票者は1度しか選ばず、size 人になるまで選び直す
+	seen := map[int]bool{}
+	var users []User
+	for len(users) < size {
+		n := getRand(r, 0, len(f.users)-1)
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		users = append(users, f.users[n])
+	}
+	return users, nil
+}
+
+// Candidates implements FixtureProvider
+func (f *FileFixture) Candidates() ([]Candidate, error) {
+	return f.candidates, nil
+}
+
+func readRecords(path string, fromCSV func([]string) error, fromJSON func([]byte) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch filepath.Ext(path) {
+	case ".csv":
+		r := csv.NewReader(bufio.NewReader(file))
+		for {
+			rec, err := r.Read()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := fromCSV(rec); err != nil {
+				return err
+			}
+		}
+	case ".jsonl":
+		s := bufio.NewScanner(file)
+		s.Buffer(make([]byte, 64*1024), 1024*1024)
+		for s.Scan() {
+			if len(strings.TrimSpace(s.Text())) == 0 {
+				continue
+			}
+			if err := fromJSON(s.Bytes()); err != nil {
+				return err
+			}
+		}
+		return s.Err()
+	default:
+		return errors.New("unknown fixture format: " + path)
+	}
+}
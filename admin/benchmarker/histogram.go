@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// 各バケットの上限は前のバケットの 1.05 倍 (誤差 5% 以内)
+const histogramGrowth = 1.05
+
+var histogramLogGrowth = math.Log(histogramGrowth)
+
+// Histogram records latencies in exponentially sized buckets
+type Histogram struct {
+	counts []int
+	total  int
+	max    time.Duration
+}
+
+func (h *Histogram) record(d time.Duration) {
+	i := bucketOf(d)
+	if i >= len(h.counts) {
+		counts := make([]int, i+1)
+		copy(counts, h.counts)
+		h.counts = counts
+	}
+	h.counts[i]++
+	h.total++
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// p パーセンタイルの値を返す
+func (h *Histogram) percentile(p float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(float64(h.total) * p / 100))
+	if rank < 1 {
+		rank = 1
+	}
+	seen := 0
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			if d := bucketUpperBound(i); d < h.max {
+				return d
+			}
+			return h.max
+		}
+	}
+	return h.max
+}
+
+// 1µs 未満はすべて 0 番目のバケットに入る
+func bucketOf(d time.Duration) int {
+	us := float64(d) / float64(time.Microsecond)
+	if us <= 1 {
+		return 0
+	}
+	return int(math.Ceil(math.Log(us) / histogramLogGrowth))
+}
+
+func bucketUpperBound(i int) time.Duration {
+	return time.Duration(math.Pow(histogramGrowth, float64(i)) * float64(time.Microsecond))
+}
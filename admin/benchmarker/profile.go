@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Profile is the settings of a benchmark run
+type Profile struct {
+	ReadyTimeout      Duration     `json:"ready_timeout"`
+	InitializeTimeout Duration     `json:"initialize_timeout"`
+	VoteDuration      Duration     `json:"vote_duration"`
+	ResultDuration    Duration     `json:"result_duration"`
+	Score             ScoreWeights `json:"score"`
+}
+
+// ScoreWeights is the points per response
+type ScoreWeights struct {
+	GetSuccess  int `json:"get_success"`
+	PostSuccess int `json:"post_success"`
+	Failure     int `json:"failure"`
+}
+
+// Duration is a time.Duration written as "45s" in JSON
+type Duration struct {
+	time.Duration
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// 競技のルール通りの設定
+func defaultProfile() Profile {
+	return Profile{
+		ReadyTimeout:      Duration{30 * time.Second},
+		InitializeTimeout: Duration{10 * time.Second},
+		VoteDuration:      Duration{45 * time.Second},
+		ResultDuration:    Duration{15 * time.Second},
+		Score: ScoreWeights{
+			GetSuccess:  2,
+			PostSuccess: 1,
+			Failure:     100,
+		},
+	}
+}
+
+var profile = defaultProfile()
+
+// JSON ファイルから読み込んだ設定を、指定されたフラグで上書きする
+func loadProfile(path string, fs *flag.FlagSet, flags Profile) (Profile, error) {
+	p := defaultProfile()
+	if path != "" {
+		// YAML には対応していない。JSON の構文エラーで分かりにくく失敗しないよう、拡張子で先に断る
+		if ext := strings.ToLower(filepath.Ext(path)); ext != ".json" {
+			return p, fmt.Errorf("%s: only JSON profiles (.json) are supported, got %q", path, ext)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return p, err
+		}
+		if err := json.Unmarshal(b, &p); err != nil {
+			return p, err
+		}
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "ready-timeout":
+			p.ReadyTimeout = flags.ReadyTimeout
+		case "initialize-timeout":
+			p.InitializeTimeout = flags.InitializeTimeout
+		case "vote-duration":
+			p.VoteDuration = flags.VoteDuration
+		case "result-duration":
+			p.ResultDuration = flags.ResultDuration
+		case "score-get":
+			p.Score.GetSuccess = flags.Score.GetSuccess
+		case "score-post":
+			p.Score.PostSuccess = flags.Score.PostSuccess
+		case "score-failure":
+			p.Score.Failure = flags.Score.Failure
+		}
+	})
+	return p, nil
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is the result of a benchmark run
+type Report struct {
+	StartedAt   time.Time                  `json:"started_at"`
+	FinishedAt  time.Time                  `json:"finished_at"`
+	Target      string                     `json:"target"`
+	Workload    int                        `json:"workload"`
+	Seed        int64                      `json:"seed"`
+	Profile     Profile                    `json:"profile"`
+	Score       int                        `json:"score"`
+	Success     int                        `json:"success"`
+	Failure     int                        `json:"failure"`
+	Validation  ValidationReport           `json:"validation"`
+	AbortReason string                     `json:"abort_reason,omitempty"`
+	Failures    Failures                   `json:"failures,omitempty"`
+	Phases      []*PhaseReport             `json:"phases"`
+	Endpoints   map[string]*EndpointReport `json:"endpoints"`
+}
+
+// ValidationReport is the result of the validation phase
+type ValidationReport struct {
+	Passed bool   `json:"passed"`
+	Error  string `json:"error,omitempty"`
+}
+
+// PhaseReport has request statistics of a phase
+type PhaseReport struct {
+	Name       string                     `json:"name"`
+	StartedAt  time.Time                  `json:"started_at"`
+	FinishedAt time.Time                  `json:"finished_at"`
+	Endpoints  map[string]*EndpointReport `json:"endpoints"`
+}
+
+// EndpointReport has request statistics of an endpoint
+type EndpointReport struct {
+	Requests int           `json:"requests"`
+	Statuses map[int]int   `json:"statuses"`
+	Latency  LatencyReport `json:"latency_ms"`
+	latency  Histogram
+}
+
+// LatencyReport has latency percentiles in milliseconds
+type LatencyReport struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// RequestRecord is a line of the JSONL request log
+type RequestRecord struct {
+	Time      time.Time `json:"time"`
+	Phase     string    `json:"phase"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Endpoint  string    `json:"endpoint"`
+	Status    int       `json:"status"`
+	LatencyMs float64   `json:"latency_ms"`
+	Error     string    `json:"error,omitempty"`
+}
+
+var (
+	report      = &Report{Endpoints: map[string]*EndpointReport{}}
+	reportMu    sync.Mutex
+	reportPath  string
+	requestLog  *json.Encoder
+	requestFile *os.File
+)
+
+// --report, --report-requests で指定されたファイルを準備する
+func setupReport(path string, requestsPath string, target string, workload int) {
+	reportPath = path
+	report.StartedAt = time.Now()
+	report.Target = target
+	report.Workload = workload
+	report.Seed = seed
+	report.Profile = profile
+	if requestsPath != "" {
+		f, err := os.Create(requestsPath)
+		if err != nil {
+			log.Fatalf("Failed to create request log: %s", err)
+		}
+		requestFile = f
+		requestLog = json.NewEncoder(f)
+	}
+}
+
+func startPhase(name string) {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	now := time.Now()
+	finishPhase(now)
+	report.Phases = append(report.Phases, &PhaseReport{
+		Name:      name,
+		StartedAt: now,
+		Endpoints: map[string]*EndpointReport{},
+	})
+}
+
+func currentPhase() string {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	if len(report.Phases) == 0 {
+		return ""
+	}
+	return report.Phases[len(report.Phases)-1].Name
+}
+
+func recordRequest(method string, path string, status int, latency time.Duration, err error) {
+	endpoint := endpointOf(method, path)
+
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	phase := ""
+	if len(report.Phases) > 0 {
+		p := report.Phases[len(report.Phases)-1]
+		phase = p.Name
+		addSample(p.Endpoints, endpoint, status, latency)
+	}
+	addSample(report.Endpoints, endpoint, status, latency)
+
+	if requestLog != nil {
+		r := RequestRecord{
+			Time:      time.Now(),
+			Phase:     phase,
+			Method:    method,
+			Path:      path,
+			Endpoint:  endpoint,
+			Status:    status,
+			LatencyMs: toMillisecond(latency),
+		}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		requestLog.Encode(r)
+	}
+}
+
+func addSample(endpoints map[string]*EndpointReport, endpoint string, status int, latency time.Duration) {
+	e, ok := endpoints[endpoint]
+	if !ok {
+		e = &EndpointReport{Statuses: map[int]int{}}
+		endpoints[endpoint] = e
+	}
+	e.Requests++
+	e.Statuses[status]++
+	e.latency.record(latency)
+}
+
+// リクエストパスからエンドポイント名を返す
+func endpointOf(method string, path string) string {
+	switch {
+	case strings.HasPrefix(path, "/candidates/"):
+		path = "/candidates/:id"
+	case strings.HasPrefix(path, "/political_parties/"):
+		path = "/political_parties/:name"
+	}
+	return method + " " + path
+}
+
+func (e *EndpointReport) summarize() {
+	e.Latency = LatencyReport{
+		P50: toMillisecond(e.latency.percentile(50)),
+		P90: toMillisecond(e.latency.percentile(90)),
+		P99: toMillisecond(e.latency.percentile(99)),
+		Max: toMillisecond(e.latency.max),
+	}
+}
+
+// 実行中のフェーズを終了してレイテンシの表を出力する
+func finishPhase(now time.Time) {
+	if len(report.Phases) == 0 {
+		return
+	}
+	p := report.Phases[len(report.Phases)-1]
+	if !p.FinishedAt.IsZero() {
+		return
+	}
+	p.FinishedAt = now
+	for _, e := range p.Endpoints {
+		e.summarize()
+	}
+	printLatencyTable(p)
+}
+
+func printLatencyTable(p *PhaseReport) {
+	if len(p.Endpoints) == 0 {
+		return
+	}
+	names := make([]string, 0, len(p.Endpoints))
+	for name := range p.Endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "endpoint\treq\tp50(ms)\tp90(ms)\tp99(ms)\tmax(ms)\t")
+	for _, name := range names {
+		e := p.Endpoints[name]
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%.1f\t%.1f\t%.1f\t\n", name, e.Requests, e.Latency.P50, e.Latency.P90, e.Latency.P99, e.Latency.Max)
+	}
+	w.Flush()
+
+	log.Print("レイテンシ (" + p.Name + ")")
+	for _, line := range strings.Split(strings.TrimRight(buf.String(), "\n"), "\n") {
+		log.Print(line)
+	}
+}
+
+func toMillisecond(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func passValidation() {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	report.Validation.Passed = true
+}
+
+// 結果をまとめて --report のファイルに書き出す
+func writeReport() {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	report.FinishedAt = time.Now()
+	report.Score = totalScore
+	report.Success = totalResp[true]
+	report.Failure = totalResp[false]
+	finishPhase(report.FinishedAt)
+	for _, e := range report.Endpoints {
+		e.summarize()
+	}
+
+	if requestFile != nil {
+		requestFile.Close()
+	}
+	if reportPath == "" {
+		return
+	}
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	if err := ioutil.WriteFile(reportPath, b, 0644); err != nil {
+		log.Print(err)
+	}
+}
+
+// 失敗の内容を記録する
+func failReport(errs Failures) {
+	reportMu.Lock()
+	defer reportMu.Unlock()
+	report.AbortReason = errs[0].Error()
+	report.Failures = errs
+	if !report.Validation.Passed {
+		report.Validation.Error = errs.Error()
+	}
+}
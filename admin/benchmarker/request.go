@@ -3,27 +3,52 @@ package main
 // 初期化(N秒以内)
 import (
 	"crypto/tls"
+	"io/ioutil"
 	"log"
 	"math/rand"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
-	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"golang.org/x/net/http2"
 )
 
-func getInitialize() {
+// GET /readyz が 200 を返すまで待つ。/readyz が無い実装 (404) は待たない
+func waitReady() error {
+	log.Print("Waiting for GET /readyz")
+	limit := profile.ReadyTimeout.Duration
+	start := time.Now()
+	for {
+		status := httpsRequest("GET", "/readyz", nil)
+		if status == http.StatusOK {
+			return nil
+		}
+		if status == http.StatusNotFound {
+			log.Print("GET /readyz is not implemented")
+			return nil
+		}
+		if elapsed := time.Since(start); elapsed > limit {
+			return newError("GET /readyz", "Timeover", limit.String(), elapsed.String())
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+func getInitialize() error {
 	log.Print("Start GET /initialize")
-	finishTime := time.Now().Add(10 * time.Second)
+	limit := profile.InitializeTimeout.Duration
+	start := time.Now()
 	httpsRequest("GET", "/initialize", nil)
-	if time.Now().Sub(finishTime) > 0 {
-		log.Print("Timeover at GET /initialize")
-		os.Exit(1)
+	if elapsed := time.Since(start); elapsed > limit {
+		return newError("GET /initialize", "Timeover", limit.String(), elapsed.String())
 	}
+	return nil
 }
 
 func postVote(v Vote) bool {
@@ -47,17 +72,17 @@ func getIndex() bool {
 	return false
 }
 
-func getCandidate() bool {
-	id := strconv.Itoa(getRand(1, 30))
+func getCandidate(r *rand.Rand) bool {
+	id := strconv.Itoa(getRand(r, 1, 30))
 	if httpsRequest("GET", "/candidates/"+id, nil) == 200 {
 		return true
 	}
 	return false
 }
 
-func getPoliticalParty() bool {
+func getPoliticalParty(r *rand.Rand) bool {
 	set := []string{"国民元気党", "国民10人大活躍党", "夢実現党", "国民平和党"}
-	party := set[getRand(0, 3)]
+	party := set[getRand(r, 0, 3)]
 	if httpsRequest("GET", "/political_parties/"+party, nil) == 200 {
 		return true
 	}
@@ -71,10 +96,17 @@ func getCSS() bool {
 	return false
 }
 
-var clients []http.Client
+var (
+	clients     []http.Client
+	sessionOnce []sync.Once
+	// クライアントごとの CSRF トークン。セッションの間は変わらないので1度だけ取得する
+	csrfTokens  []string
+	csrfTokenMu sync.Mutex
+	nextClient  uint32
+)
 
 func createClients(size int) {
-	clients = make([]http.Client, size)
+	cs := make([]http.Client, size)
 	for i := 0; i < size; i++ {
 		tr := &http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -82,43 +114,122 @@ func createClients(size int) {
 		if err := http2.ConfigureTransport(tr); err != nil {
 			log.Fatalf("Failed to configure h2 transport: %s", err)
 		}
-		clients[i] = http.Client{Transport: tr}
+		// POST /vote をリダイレクトする実装では結果がセッションに入るので、クライアントごとに cookie を持つ
+		jar, _ := cookiejar.New(nil)
+		cs[i] = http.Client{Transport: tr, Jar: jar}
 	}
-	rand.Seed(time.Now().Unix())
+	setClients(cs)
 }
 
-func httpsRequest(method string, path string, params url.Values) int {
+// setClients makes the benchmarker send requests with cs in turn
+func setClients(cs []http.Client) {
+	clients = cs
+	sessionOnce = make([]sync.Once, len(cs))
+	csrfTokens = make([]string, len(cs))
+}
+
+// クライアントは順番に使う
+func pickClient() int {
+	return int(atomic.AddUint32(&nextClient, 1) % uint32(len(clients)))
+}
+
+// --csrf の場合は、クライアントごとに最初の POST /vote の前に1度だけ GET /vote し、フォームの csrf_token を送る
+var csrf = false
+
+func needsCSRFToken(method string, path string) bool {
+	return csrf && method == "POST" && path == "/vote"
+}
+
+func newRequest(i int, method string, path string, params url.Values) *http.Request {
+	if needsCSRFToken(method, path) {
+		// 同時に最初の GET /vote をするとセッションが別々に作られてしまうので、クライアントごとに1度だけ先に取得する
+		sessionOnce[i].Do(func() { refreshCSRFToken(i) })
+		csrfTokenMu.Lock()
+		token := csrfTokens[i]
+		csrfTokenMu.Unlock()
+
+		p := url.Values{}
+		for k, v := range params {
+			p[k] = v
+		}
+		p.Set("csrf_token", token)
+		params = p
+	}
 	req, _ := http.NewRequest(method, host+path, strings.NewReader(params.Encode()))
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	client := clients[rand.Intn(len(clients))]
+	return req
+}
+
+// 403 はセッションが切れたかトークンが変わったので、次の POST /vote のために取り直す
+func afterResponse(i int, method string, path string, status int) {
+	if needsCSRFToken(method, path) && status == http.StatusForbidden {
+		refreshCSRFToken(i)
+	}
+}
 
-	resp, err := client.Do(req)
+// 取得できなければトークン無しで送り、POST /vote の失敗として扱う
+func refreshCSRFToken(i int) {
+	token := fetchCSRFToken(&clients[i])
+	csrfTokenMu.Lock()
+	csrfTokens[i] = token
+	csrfTokenMu.Unlock()
+}
+
+func fetchCSRFToken(client *http.Client) string {
+	start := time.Now()
+	resp, err := client.Get(host + "/vote")
 	if err != nil {
 		log.Print(err)
+		recordRequest("GET", "/vote", 500, time.Since(start), err)
+		return ""
+	}
+	defer resp.Body.Close()
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	recordRequest("GET", "/vote", resp.StatusCode, time.Since(start), err)
+	if err != nil {
+		return ""
+	}
+	token, _ := doc.Find(`input[name="csrf_token"]`).Attr("value")
+	return token
+}
+
+func httpsRequest(method string, path string, params url.Values) int {
+	i := pickClient()
+	req := newRequest(i, method, path, params)
+
+	start := time.Now()
+	resp, err := clients[i].Do(req)
+	if err != nil {
+		log.Print(err)
+		recordRequest(method, path, 500, time.Since(start), err)
 		return 500
 	}
 	defer resp.Body.Close()
+	ioutil.ReadAll(resp.Body)
+	recordRequest(method, path, resp.StatusCode, time.Since(start), nil)
+	afterResponse(i, method, path, resp.StatusCode)
 
 	return resp.StatusCode
 }
 
-func httpsRequestDoc(method string, path string, params url.Values) *goquery.Document {
-	req, _ := http.NewRequest(method, host+path, strings.NewReader(params.Encode()))
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	client := clients[rand.Intn(len(clients))]
+func httpsRequestDoc(method string, path string, params url.Values) (*goquery.Document, error) {
+	i := pickClient()
+	req := newRequest(i, method, path, params)
 
-	resp, err := client.Do(req)
+	start := time.Now()
+	resp, err := clients[i].Do(req)
 	if err != nil {
-		log.Print(err)
-		os.Exit(1)
+		recordRequest(method, path, 500, time.Since(start), err)
+		return nil, newError(endpointOf(method, path), err.Error(), "", "")
 	}
 	defer resp.Body.Close()
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	recordRequest(method, path, resp.StatusCode, time.Since(start), err)
+	afterResponse(i, method, path, resp.StatusCode)
 	if err != nil {
-		log.Print(err)
-		os.Exit(1)
+		return nil, newError(endpointOf(method, path), err.Error(), "", "")
 	}
 
-	return doc
+	return doc, nil
 }
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCSRFTokenIsReused(t *testing.T) {
+	var gets, token int32 = 0, 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := strconv.Itoa(int(atomic.LoadInt32(&token)))
+		if r.Method == "GET" {
+			atomic.AddInt32(&gets, 1)
+			w.Write([]byte(`<form><input type="hidden" name="csrf_token" value="` + want + `"></form>`))
+			return
+		}
+		if r.PostFormValue("csrf_token") != want {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+	host = srv.URL
+	setClients([]http.Client{*srv.Client()})
+	csrf = true
+	defer func() { csrf = false }()
+
+	for i := 0; i < 3; i++ {
+		if status := httpsRequest("POST", "/vote", url.Values{}); status != http.StatusOK {
+			t.Fatalf("vote %d = %d, want 200", i, status)
+		}
+	}
+	if n := atomic.LoadInt32(&gets); n != 1 {
+		t.Errorf("GET /vote is sent %d times for 3 votes, want 1", n)
+	}
+
+	// トークンが変わると 403 の後に取り直す
+	atomic.StoreInt32(&token, 2)
+	if status := httpsRequest("POST", "/vote", url.Values{}); status != http.StatusForbidden {
+		t.Fatalf("vote with an old token = %d, want 403", status)
+	}
+	if status := httpsRequest("POST", "/vote", url.Values{}); status != http.StatusOK {
+		t.Errorf("vote after the 403 = %d, want 200", status)
+	}
+	if n := atomic.LoadInt32(&gets); n != 2 {
+		t.Errorf("GET /vote is sent %d times, want 2", n)
+	}
+}
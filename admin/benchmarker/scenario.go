@@ -1,14 +1,16 @@
 package main
 
 import (
-	"log"
-	"os"
+	"math/rand"
 	"sync"
 	"time"
 )
 
-func voteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool {
-	voteSet := setupVotes(50, false)
+func voteScenario(r *rand.Rand, m *sync.Mutex, finishTime time.Time) (bool, error) {
+	voteSet, err := setupVotes(r, 50, false)
+	if err != nil {
+		return true, err
+	}
 	resps := map[bool]int{}
 	resp := true
 
@@ -16,24 +18,26 @@ func voteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool
 		resp = postVote(vote)
 		resps[resp]++
 		if resp == false {
-			log.Print("投票に失敗しました at POST /vote")
-			os.Exit(1)
+			return true, newError("POST /vote", "投票に失敗しました", "200", "")
 		}
 	}
 
-	return updateScore("POST", resps, wg, m, finishTime)
+	return updateScore("POST", resps, m, finishTime), nil
 }
 
-func invalidVoteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool {
-	voteSet := setupVotes(50, false)
+func invalidVoteScenario(r *rand.Rand, m *sync.Mutex, finishTime time.Time) (bool, error) {
+	voteSet, err := setupVotes(r, 50, false)
+	if err != nil {
+		return true, err
+	}
 	resps := map[bool]int{}
 	resp := true
 
 	for _, vote := range voteSet {
-		r := getRand(1, 3)
-		if r == 1 {
+		n := getRand(r, 1, 3)
+		if n == 1 {
 			vote.Name = "hoge"
-		} else if r == 2 {
+		} else if n == 2 {
 			vote.Address = "hoge"
 		} else {
 			vote.Mynumber = "hoge"
@@ -41,15 +45,14 @@ func invalidVoteScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time
 		resp = postVote(vote)
 		resps[resp]++
 		if resp == false {
-			log.Print("投票に失敗しました at POST /vote")
-			os.Exit(1)
+			return true, newError("POST /vote", "投票に失敗しました", "200", "")
 		}
 	}
 
-	return updateScore("POST", resps, wg, m, finishTime)
+	return updateScore("POST", resps, m, finishTime), nil
 }
 
-func indexScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool {
+func indexScenario(r *rand.Rand, m *sync.Mutex, finishTime time.Time) bool {
 	resps := map[bool]int{}
 	resp := true
 
@@ -59,52 +62,46 @@ func indexScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool
 		resp = getCSS()
 		resps[resp]++
 	}
-	return updateScore("GET", resps, wg, m, finishTime)
+	return updateScore("GET", resps, m, finishTime)
 }
 
-func candidateScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool {
+func candidateScenario(r *rand.Rand, m *sync.Mutex, finishTime time.Time) bool {
 	resps := map[bool]int{}
 	resp := true
 
 	for i := 0; i < 4; i++ {
-		resp = getCandidate()
+		resp = getCandidate(r)
 		resps[resp]++
 		resp = getCSS()
 		resps[resp]++
 	}
-	return updateScore("GET", resps, wg, m, finishTime)
+	return updateScore("GET", resps, m, finishTime)
 }
 
-func politicalPartyScenario(wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) bool {
+func politicalPartyScenario(r *rand.Rand, m *sync.Mutex, finishTime time.Time) bool {
 	resps := map[bool]int{}
 	resp := true
 
 	for i := 0; i < 4; i++ {
-		resp = getPoliticalParty()
+		resp = getPoliticalParty(r)
 		resps[resp]++
 		resp = getCSS()
 		resps[resp]++
 	}
-	return updateScore("GET", resps, wg, m, finishTime)
+	return updateScore("GET", resps, m, finishTime)
 }
 
 // 以下、スコア計算用
-func updateScore(method string, resps map[bool]int, wg *sync.WaitGroup, m *sync.Mutex, finishTime time.Time) (finished bool) {
+func updateScore(method string, resps map[bool]int, m *sync.Mutex, finishTime time.Time) bool {
 	m.Lock()
 	defer m.Unlock()
 	if method == "GET" {
-		totalScore = totalScore + resps[true]*2
-		totalScore = totalScore - resps[false]*100
+		totalScore = totalScore + resps[true]*profile.Score.GetSuccess
+		totalScore = totalScore - resps[false]*profile.Score.Failure
 	} else {
-		totalScore = totalScore + resps[true]*1
+		totalScore = totalScore + resps[true]*profile.Score.PostSuccess
 	}
 	totalResp[true] = totalResp[true] + resps[true]
 	totalResp[false] = totalResp[false] + resps[false]
-	if time.Now().After(finishTime) {
-		finished = true
-		wg.Done()
-	} else {
-		finished = false
-	}
-	return finished
+	return time.Now().After(finishTime)
 }
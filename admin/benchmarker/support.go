@@ -1,12 +1,8 @@
 package main
 
 import (
-	"database/sql"
 	"math/rand"
 	"strconv"
-	"time"
-
-	_ "github.com/go-sql-driver/mysql"
 )
 
 // Vote information
@@ -27,56 +23,54 @@ type Candidate struct {
 	Sex   string
 }
 
-func setupVotes(size int, forValidate bool) []Vote {
+func setupVotes(r *rand.Rand, size int, forValidate bool) ([]Vote, error) {
 	var voteSet []Vote
 
-	db, err := sql.Open("mysql", "ishocon:ishocon@/ishocon2")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer db.Close()
-
 	// size 人数分の投票者を選ぶ
-	query := "SELECT name, address, mynumber, votes FROM users WHERE id IN ("
-	for i := 0; i < size; i++ {
-		id := strconv.Itoa(getRand(1, 4000000))
-		query = query + id + ","
-	}
-	query = query + "0)"
-
-	rows, err := db.Query(query)
+	users, err := fixture.RandomUsers(r, size)
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
-	defer rows.Close()
-	for rows.Next() {
-		var v Vote
-		var strMaxVoteCount string
-		err = rows.Scan(&v.Name, &v.Address, &v.Mynumber, &strMaxVoteCount)
-		maxVoteCount, _ := strconv.Atoi(strMaxVoteCount)
+	for _, u := range users {
+		v := Vote{Name: u.Name, Address: u.Address, Mynumber: u.Mynumber}
 		if forValidate {
-			v.VoteCount = strconv.Itoa(getRand(1, 4))
+			v.VoteCount = strconv.Itoa(getRand(r, 1, 4))
 		} else {
-			v.VoteCount = strconv.Itoa(getRand(1, maxVoteCount))
+			v.VoteCount = strconv.Itoa(getRand(r, 1, u.Votes))
 		}
-		v.Candidate = getRandCandidate()
-		v.Keyword = getRandKeyword()
+		v.Candidate = getRandCandidate(r)
+		v.Keyword = getRandKeyword(r)
 
 		voteSet = append(voteSet, v)
 	}
 
-	return voteSet
+	return voteSet, nil
+}
+
+// --seed で指定された乱数のシード
+var seed int64
+
+// goroutine ごとに乱数列を作る。同じ seed と id からは同じ乱数列が得られる。
+// seed + id だと隣の seed と id がずれただけの乱数列になるので、splitmix64 で混ぜる
+func newRand(id int64) *rand.Rand {
+	return rand.New(rand.NewSource(int64(splitmix64(splitmix64(uint64(seed)) + uint64(id)))))
+}
+
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
 }
 
 // from から to までの値をランダムに取得
-func getRand(from int, to int) int {
-	rand.Seed(time.Now().UnixNano())
-	return rand.Intn(to+1-from) + from
+func getRand(r *rand.Rand, from int, to int) int {
+	return r.Intn(to+1-from) + from
 }
 
-func getRandCandidate() string {
+func getRandCandidate(r *rand.Rand) string {
 	set := []string{"佐藤 一郎", "佐藤 次郎", "佐藤 三郎", "佐藤 四郎", "佐藤 五郎", "鈴木 一郎", "鈴木 次郎", "鈴木 三郎", "鈴木 四郎", "鈴木 五郎", "高橋 一郎", "高橋 次郎", "高橋 三郎", "高橋 四郎", "高橋 五郎", "田中 一郎", "田中 次郎", "田中 三郎", "田中 四郎", "田中 五郎", "渡辺 一郎", "渡辺 次郎", "渡辺 三郎", "渡辺 四郎", "渡辺 五郎", "伊藤 一郎", "伊藤 次郎", "伊藤 三郎", "伊藤 四郎", "伊藤 五郎"}
-	n := getRand(0, 8)
+	n := getRand(r, 0, 8)
 	id := 0
 	if n == 0 {
 		id = 3
@@ -85,18 +79,18 @@ func getRandCandidate() string {
 	} else if n == 2 {
 		id = 22
 	} else if n == 3 || n == 4 {
-		id = getRand(0, 10)
+		id = getRand(r, 0, 10)
 	} else if n == 5 {
-		id = getRand(1, 20)
+		id = getRand(r, 1, 20)
 	} else if n == 6 {
-		id = getRand(25, 29)
+		id = getRand(r, 25, 29)
 	} else {
-		id = getRand(13, 22)
+		id = getRand(r, 13, 22)
 	}
 	return set[id]
 }
 
-func getRandKeyword() string {
+func getRandKeyword(r *rand.Rand) string {
 	set := []string{
 		"他にまともな候補者がいないため",
 		"誠実さ",
@@ -124,71 +118,34 @@ func getRandKeyword() string {
 		"教えてたくない",
 		"自分でもなぜか分からない",
 	}
-	seed := getRand(1, 4)
+	n := getRand(r, 1, 4)
 	i := 0
-	if seed == 1 {
+	if n == 1 {
 		i = 0
-	} else if seed == 2 {
-		i = getRand(0, 10)
-	} else if seed == 3 {
-		i = getRand(0, 20)
+	} else if n == 2 {
+		i = getRand(r, 0, 10)
+	} else if n == 3 {
+		i = getRand(r, 0, 20)
 	} else {
-		i = getRand(21, 24)
+		i = getRand(r, 21, 24)
 	}
 	return set[i]
 }
 
 func getCndInfo(name string) Candidate {
-	db, err := sql.Open("mysql", "ishocon:ishocon@/ishocon2")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer db.Close()
-
-	var c Candidate
-	err = db.QueryRow("SELECT * FROM candidates WHERE name = ? LIMIT 1", name).Scan(&c.ID, &c.Name, &c.Party, &c.Sex)
-	if err != nil {
-		panic(err.Error())
-	}
-	return c
+	return candidatesByName[name]
 }
 
 // 候補者名から政党名を返す
 func getPatryInfo(name string) string {
-	db, err := sql.Open("mysql", "ishocon:ishocon@/ishocon2")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer db.Close()
-
-	var party string
-	err = db.QueryRow("SELECT political_party FROM candidates WHERE name = ? LIMIT 1", name).Scan(&party)
-	if err != nil {
-		panic(err.Error())
-	}
-	return party
+	return candidatesByName[name].Party
 }
 
 func membersOf(party string) (members []string) {
-	db, err := sql.Open("mysql", "ishocon:ishocon@/ishocon2")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer db.Close()
-
-	rows, err := db.Query("SELECT name FROM candidates WHERE political_party = ?", party)
-	if err != nil {
-		panic(err.Error())
-	}
-	defer rows.Close()
-	for rows.Next() {
-		var name string
-		err = rows.Scan(&name)
-		if err != nil {
-			panic(err.Error())
+	for _, c := range candidates {
+		if c.Party == party {
+			members = append(members, c.Name)
 		}
-		members = append(members, name)
 	}
-
 	return
 }
@@ -1,9 +1,8 @@
 package main
 
 import (
-	"log"
+	"math/rand"
 	"net/url"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,16 +11,25 @@ import (
 )
 
 // 初期化確認
-func validateInitialize() {
-	voteSet := setupVotes(150, true)
-	validateVote(voteSet)
-	validateVoteError(voteSet)
-	validateIndex(voteSet)
-	validateCandidate(voteSet)
-	validatePoliticalParty(voteSet)
+func validateInitialize(r *rand.Rand) error {
+	voteSet, err := setupVotes(r, 150, true)
+	if err != nil {
+		return err
+	}
+	// 投票が反映されていないと以降の確認は意味がないので打ち切る
+	if err := validateVote(voteSet); err != nil {
+		return err
+	}
+
+	var errs Failures
+	errs.add(validateVoteError(voteSet))
+	errs.add(validateIndex(voteSet))
+	errs.add(validateCandidate(voteSet))
+	errs.add(validatePoliticalParty(voteSet))
+	return errs.err()
 }
 
-func validateVote(voteSet []Vote) {
+func validateVote(voteSet []Vote) error {
 	for _, v := range voteSet {
 		params := url.Values{}
 		params.Add("name", v.Name)
@@ -31,24 +39,32 @@ func validateVote(voteSet []Vote) {
 		params.Add("keyword", v.Keyword)
 		params.Add("vote_count", v.VoteCount)
 
-		doc := httpsRequestDoc("POST", "/vote", params)
+		doc, err := httpsRequestDoc("POST", "/vote", params)
+		if err != nil {
+			return err
+		}
 
 		// 投票が成功したことの確認
 		message := doc.Find(".text-danger").Text()
 		if !strings.Contains(message, "投票に成功しました") {
-			log.Print("正しい情報で投票ができません at POST /vote")
-			os.Exit(1)
+			return newError("POST /vote", "正しい情報で投票ができません", "投票に成功しました", message)
 		}
 
 		// DOMの構造確認
-		if doc.Find("fieldset").Children().Size() != 14 {
-			log.Print("DOM の構造が正しくありません at POST /vote")
-			os.Exit(1)
+		if size := doc.Find("fieldset").Children().Size(); size != 14 {
+			return newError("POST /vote", "DOM の構造が正しくありません", "14", strconv.Itoa(size))
 		}
 	}
+	return nil
 }
 
-func validateVoteError(voteSet []Vote) {
+func validateVoteError(voteSet []Vote) error {
+	// 以下の 7 つのケースにそれぞれ別の投票者を使う
+	if len(voteSet) < 7 {
+		return newError("POST /vote", "確認に使う投票者が足りません", "7", strconv.Itoa(len(voteSet)))
+	}
+	var errs Failures
+
 	// Case1: 個人情報に誤りがある場合
 	v1 := voteSet[0]
 
@@ -60,13 +76,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v1.Keyword)
 	params.Add("vote_count", "0")
 
-	doc := httpsRequestDoc("POST", "/vote", params)
+	doc, err := httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message := doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "個人情報に誤りがあります") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "個人情報に誤りがあります", message))
 	}
 
 	// Case2: 個人情報に誤りがある場合
@@ -80,13 +98,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v2.Keyword)
 	params.Add("vote_count", "0")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "個人情報に誤りがあります") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "個人情報に誤りがあります", message))
 	}
 
 	// Case3: 個人情報に誤りがある場合
@@ -100,13 +120,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v3.Keyword)
 	params.Add("vote_count", "0")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "個人情報に誤りがあります") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "個人情報に誤りがあります", message))
 	}
 
 	// Case4: 投票数が上限を超えている場合
@@ -120,13 +142,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v4.Keyword)
 	params.Add("vote_count", "220")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "投票数が上限を超えています") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "投票数が上限を超えています", message))
 	}
 
 	// Case5: 候補者が未記入の場合
@@ -140,13 +164,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v5.Keyword)
 	params.Add("vote_count", "0")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "候補者を記入してください") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "候補者を記入してください", message))
 	}
 
 	// Case6: 候補者名が誤りの場合
@@ -160,13 +186,15 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", v6.Keyword)
 	params.Add("vote_count", "0")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "候補者を正しく記入してください") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "候補者を正しく記入してください", message))
 	}
 
 	// Case7: 投票理由が空の場合
@@ -180,28 +208,36 @@ func validateVoteError(voteSet []Vote) {
 	params.Add("keyword", "")
 	params.Add("vote_count", "0")
 
-	doc = httpsRequestDoc("POST", "/vote", params)
+	doc, err = httpsRequestDoc("POST", "/vote", params)
+	if err != nil {
+		return err
+	}
 
 	// 投票が成功したことの確認
 	message = doc.Find(".text-danger").Text()
 	if !strings.Contains(message, "投票理由を記入してください") {
-		log.Print("エラーメッセージに誤りがあります at POST /vote")
-		os.Exit(1)
+		errs.add(newError("POST /vote", "エラーメッセージに誤りがあります", "投票理由を記入してください", message))
 	}
+	return errs.err()
 }
 
-func validateIndex(voteSet []Vote) {
-	doc := httpsRequestDoc("GET", "/", nil)
+func validateIndex(voteSet []Vote) error {
+	doc, err := httpsRequestDoc("GET", "/", nil)
+	if err != nil {
+		return err
+	}
 
 	// DOM の確認
-	ppErrFlg := doc.Find("#people").Children().Size() != 11
-	ptErrFlg := doc.Find("#parties").Children().Size() != 4
-	sxErrFlg := doc.Find("#sex_ratio").Children().Size() != 2
-	if ppErrFlg || ptErrFlg || sxErrFlg {
-		log.Print("DOMの構造が正しくありません at GET /index")
-		os.Exit(1)
+	ppSize := doc.Find("#people").Children().Size()
+	ptSize := doc.Find("#parties").Children().Size()
+	sxSize := doc.Find("#sex_ratio").Children().Size()
+	if ppSize != 11 || ptSize != 4 || sxSize != 2 {
+		actual := strconv.Itoa(ppSize) + ", " + strconv.Itoa(ptSize) + ", " + strconv.Itoa(sxSize)
+		return newError("GET /index", "DOMの構造が正しくありません", "11, 4, 2", actual)
 	}
 
+	var errs Failures
+
 	// 個人の部の結果確認
 	rank := map[string]int{}
 	for _, v := range voteSet {
@@ -226,8 +262,7 @@ func validateIndex(voteSet []Vote) {
 			cand2 := strconv.Itoa(i+1) + ". " + l[l.Len()-1-i].name
 			cand3 := strconv.Itoa(i+1) + ". " + l[l.Len()-2-i].name
 			if !strings.Contains(str, cand1) && !strings.Contains(str, cand2) && !strings.Contains(str, cand3) {
-				log.Print("個人の部の選挙結果が正しくありません at GET /")
-				os.Exit(1)
+				errs.add(newError("GET /", "個人の部の選挙結果が正しくありません", cand2, str))
 			}
 		}
 	})
@@ -257,8 +292,7 @@ func validateIndex(voteSet []Vote) {
 			cand2 := strconv.Itoa(i+1) + ". " + l[l.Len()-1-i].name
 			cand3 := strconv.Itoa(i+1) + ". " + l[l.Len()-2-i].name
 			if !strings.Contains(str, cand1) && !strings.Contains(str, cand2) && !strings.Contains(str, cand3) {
-				log.Print("政党の部の選挙結果が正しくありません at GET /")
-				os.Exit(1)
+				errs.add(newError("GET /", "政党の部の選挙結果が正しくありません", cand2, str))
 			}
 		}
 	})
@@ -277,17 +311,14 @@ func validateIndex(voteSet []Vote) {
 		if i < 3 {
 			str := s.Text()
 			if !strings.Contains(str, man) && !strings.Contains(str, women) {
-				log.Println(str)
-				log.Println("man:" + man)
-				log.Println("woman:" + women)
-				log.Print("男女比率の選挙結果が正しくありません at GET /")
-				os.Exit(1)
+				errs.add(newError("GET /", "男女比率の選挙結果が正しくありません", "man:"+man+" woman:"+women, str))
 			}
 		}
 	})
+	return errs.err()
 }
 
-func validateCandidate(voteSet []Vote) {
+func validateCandidate(voteSet []Vote) error {
 	rank := map[string]int{}
 	for _, v := range voteSet {
 		cnt, _ := strconv.Atoi(v.VoteCount)
@@ -301,29 +332,31 @@ func validateCandidate(voteSet []Vote) {
 	sort.Sort(l)
 
 	// 上位2人の個人ページを確認する
+	var errs Failures
 	for i, cnd := range l {
 		if i >= l.Len()-2 {
 			cndInfo := getCndInfo(cnd.name)
-			doc := httpsRequestDoc("GET", "/candidates/"+cndInfo.ID, nil)
+			doc, err := httpsRequestDoc("GET", "/candidates/"+cndInfo.ID, nil)
+			if err != nil {
+				errs.add(err)
+				continue
+			}
 			doc.Find("#info p").Each(func(i int, s *goquery.Selection) {
 				str := s.Text()
 				if i == 0 {
 					// 得票数の確認
 					if !strings.Contains(str, strconv.Itoa(cnd.value)) {
-						log.Print("得票数の情報が正しくありません at GET /candidates/:id")
-						os.Exit(1)
+						errs.add(newError("GET /candidates/:id", "得票数の情報が正しくありません", strconv.Itoa(cnd.value), str))
 					}
 				} else if i == 1 {
 					// 政党名の確認
 					if !strings.Contains(str, cndInfo.Party) {
-						log.Print("政党の情報が正しくありません at GET /candidates/:id")
-						os.Exit(1)
+						errs.add(newError("GET /candidates/:id", "政党の情報が正しくありません", cndInfo.Party, str))
 					}
 				} else if i == 2 {
 					// 性別の確認
 					if !strings.Contains(str, cndInfo.Sex) {
-						log.Print("性別の情報が正しくありません at GET /candidates/:id")
-						os.Exit(1)
+						errs.add(newError("GET /candidates/:id", "性別の情報が正しくありません", cndInfo.Sex, str))
 					}
 				}
 			})
@@ -355,17 +388,20 @@ func validateCandidate(voteSet []Vote) {
 					key3 := keyList[keyList.Len()-2-i].name
 					key4 := keyList[keyList.Len()-3-i].name
 					if !strings.Contains(str, key1) && !strings.Contains(str, key2) && !strings.Contains(str, key3) && !strings.Contains(str, key4) {
-						log.Print("支持者の声が正しくありません at GET /candidates/:id")
-						os.Exit(1)
+						errs.add(newError("GET /candidates/:id", "支持者の声が正しくありません", key2, str))
 					}
 				}
 			})
 		}
 	}
+	return errs.err()
 }
 
-func validatePoliticalParty(voteSet []Vote) {
-	doc := httpsRequestDoc("GET", "/political_parties/国民元気党", nil)
+func validatePoliticalParty(voteSet []Vote) error {
+	doc, err := httpsRequestDoc("GET", "/political_parties/国民元気党", nil)
+	if err != nil {
+		return err
+	}
 
 	var votes int
 	keyRank := map[string]int{}
@@ -380,9 +416,9 @@ func validatePoliticalParty(voteSet []Vote) {
 	// 得票数の確認
 	docVotesTxt := doc.Find("#votes").Text()
 	docVotes, _ := strconv.Atoi(docVotesTxt)
+	var errs Failures
 	if docVotes != votes {
-		log.Print("得票数が正しくありません at GET /political_parties/:name")
-		os.Exit(1)
+		errs.add(newError("GET /political_parties/:name", "得票数が正しくありません", strconv.Itoa(votes), docVotesTxt))
 	}
 
 	// 党員の確認
@@ -396,8 +432,7 @@ func validatePoliticalParty(voteSet []Vote) {
 			}
 		}
 		if !flg {
-			log.Print("候補者が正しくありません at GET /political_parties/:name")
-			os.Exit(1)
+			errs.add(newError("GET /political_parties/:name", "候補者が正しくありません", strings.Join(memberSet, ", "), str))
 		}
 	})
 
@@ -419,11 +454,11 @@ func validatePoliticalParty(voteSet []Vote) {
 			key3 := keyList[keyList.Len()-2-i].name
 			key4 := keyList[keyList.Len()-3-i].name
 			if !strings.Contains(str, key1) && !strings.Contains(str, key2) && !strings.Contains(str, key3) && !strings.Contains(str, key4) {
-				log.Print("支持者の声が正しくありません at GET /political_parties/:name")
-				os.Exit(1)
+				errs.add(newError("GET /political_parties/:name", "支持者の声が正しくありません", key2, str))
 			}
 		}
 	})
+	return errs.err()
 }
 
 // follows for sort
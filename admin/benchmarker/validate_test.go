@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// TestValidate runs the validation phase against a webapp which rejects every vote
+func TestValidate(t *testing.T) {
+	f := &FileFixture{}
+	for id := 1; id <= 200; id++ {
+		f.users = append(f.users, User{ID: id, Name: "投票者 " + strconv.Itoa(id), Address: "東京都", Mynumber: strconv.Itoa(id), Votes: 10})
+	}
+	f.candidates = []Candidate{{ID: "1", Name: "佐藤 一郎", Party: "夢実現党", Sex: "男"}}
+	if err := setupFixture(f); err != nil {
+		t.Fatal(err)
+	}
+
+	var votes int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/vote" && r.Method == "POST" {
+			atomic.AddInt32(&votes, 1)
+			w.Write([]byte(`<html><body><p class="text-danger">個人情報に誤りがあります</p></body></html>`))
+		}
+	}))
+	defer srv.Close()
+
+	err := Validate(srv.URL+"/", srv.Client())
+	if err == nil || !strings.Contains(err.Error(), "正しい情報で投票ができません") {
+		t.Errorf("Validate = %v, want the failure of POST /vote", err)
+	}
+	if votes != 1 {
+		t.Errorf("%d votes are sent, want 1 before giving up", votes)
+	}
+}
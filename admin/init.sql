@@ -1,3 +1,4 @@
+-- Go 実装は votes.count 列を使うので、読み込んだ後に webapp/go で `./webapp migrate up` を実行すること
 DROP TABLE IF EXISTS users;
 DROP TABLE IF EXISTS candidates;
 DROP TABLE IF EXISTS votes;
@@ -0,0 +1,846 @@
+package main
+
+// admin/insert.rb, admin/insert_sample_votes.rb と同じデータ
+
+// 都道府県
+var prefectures = []string{
+	"北海道", "青森県", "岩手県", "宮城県", "秋田県", "山形県", "福島県", "茨城県", "栃木県", "群馬県",
+	"埼玉県", "千葉県", "東京都", "神奈川県", "新潟県", "富山県", "石川県", "福井県", "山梨県", "長野県",
+	"岐阜県", "静岡県", "愛知県", "三重県", "滋賀県", "京都府", "大阪府", "兵庫県", "奈良県", "和歌山県",
+	"鳥取県", "島根県", "岡山県", "広島県", "山口県", "徳島県", "香川県", "愛媛県", "高知県", "福岡県",
+	"佐賀県", "長崎県", "熊本県", "大分県", "宮崎県", "鹿児島県", "沖縄県",
+}
+
+// 投票者の姓
+var lastNames = []string{
+	"ナカノ", "ホンダ", "イイツカ", "タムラ", "タニカワ", "フジサワ", "サノ", "キタノ", "コハラ", "ウツミ",
+	"キタジマ", "タハラ", "オオハラ", "ドイ", "ヒラヤマ", "オクタ", "コウノ", "オオムラ", "カイ", "モリヤマ",
+	"タカマツ", "キシタ", "ヒノ", "イシハラ", "オカベ", "ホンダ", "フジタ", "ユアサ", "タニグチ", "フジカワ",
+	"オカムラ", "アマノ", "ミズタニ", "ミウラ", "クロサワ", "ナカニシ", "ヤマネ", "アラタ", "ナカムラ", "サカイ",
+	"ヒロセ", "タカタ", "イノウエ", "アラカワ", "タケシタ", "ヒラタ", "カナキ", "サイトウ", "ミウラ", "ヨシオカ",
+	"ヤマグチ", "マイタ", "タグチ", "スダ", "ホリ", "ホリウチ", "ハットリ", "ホリエ", "モチヅキ", "カナキ",
+	"ミヤケ", "ミヤケ", "ヤガワ", "スギウラ", "ハセガワ", "カイ", "フジハラ", "ヒガシ", "モリオカ", "マツモト",
+	"ノナカ", "オヤナギ", "ウエノ", "ナリタ", "ハマノ", "ヨコイ", "エンドウ", "オオシマ", "ニシムラ", "モチヅキ",
+	"アキタ", "ワカバヤシ", "イシダ", "ミタニ", "ナカシマ", "ヤマモト", "セト", "オオヒラ", "ウツミ", "ノダ",
+	"ニシノ", "オオニシ", "ヤマモト", "フジモト", "ヤマナカ", "カイ", "ミヤケ", "シライ", "ハマノ", "タナベ",
+	"コジマ", "オオバ", "ウエダ", "ナガノ", "ナカタ", "ニシノ", "ウチウミ", "クロタ", "コクラ", "イナダ",
+	"ヨコヤマ", "コモリ", "ヤギ", "カマタ", "アライ", "コイズミ", "アオヤギ", "アイザワ", "タカノ", "ヤジマ",
+	"アラカワ", "トダ", "ナカシマ", "マツモト", "オオバ", "ウエムラ", "アンドウ", "フジオカ", "カノウ", "トミタ",
+	"サイキ", "オノ", "カワバタ", "アライ", "キタハラ", "ミワ", "コヤナギ", "カワムラ", "イナダ", "タナカ",
+	"ウエダ", "ツジ", "ツチイ", "オガサハラ", "ナカイ", "ヒダカ", "タバタ", "ミワ", "フジカワ", "ヤガワ",
+	"シタムラ", "キタノ", "ホンマ", "イワタ", "オクヤマ", "アイザワ", "ホリウチ", "ノムラ", "セト", "カイ",
+	"コマツ", "イリエ", "サイトウ", "オハラ", "ウエノ", "サカモト", "ニシハラ", "ハヤカワ", "オオタ", "オオウチ",
+	"ナガサワ", "ハラタ", "オオヤマ", "オクノ", "ヒロタ", "カワノ", "ハマグチ", "セキネ", "ミヤザワ", "シンタニ",
+	"ソノダ", "ツツイ", "マツオ", "コジマ", "モテキ", "ヤマサキ", "マキノ", "コヤマ", "オダ", "タケイ",
+	"ヒガシ", "カナキ", "マエカワ", "モリヤマ", "モリシタ", "タグチ", "マイタ", "ワタナベ", "ヤガワ", "テラダ",
+	"エグチ", "スギハラ", "トミタ", "アキモト", "モリモト", "オオサワ", "カワハラ", "オウチ", "ヤマサキ", "ホリグチ",
+	"コウノ", "ヨシザワ", "ホリウチ", "シマサキ", "オオサワ", "フジオカ", "コイズミ", "シラカワ", "フクオカ", "オオタ",
+	"カワバタ", "フジオカ", "ミヤモト", "クハバラ", "ニシ", "アラカワ", "クロキ", "ウエタ", "マツムラ", "タケモト",
+	"スガ", "カシワギ", "セキグチ", "アキタ", "ヨコヤマ", "カネタ", "コイデ", "シタムラ", "マツヤマ", "ダイジョウ",
+	"アオヤギ", "スガノ", "タグチ", "タキサワ", "ウチウミ", "ミヤザキ", "コモリ", "サイトウ", "コモリ", "キタカワ",
+	"オオニシ", "ヤスタ", "ツルタ", "テヅカ", "サカキハラ", "フルタ", "ナガオカ", "イナダ", "トダ", "トミタ",
+	"カワハラ", "ダイサキ", "キンジョウ", "ミズノ", "カワムラ", "ヨシモト", "カン", "ナカニシ", "イトウ", "サカキハラ",
+	"イグチ", "カナキ", "オオムラ", "デグチ", "モリシタ", "カワタ", "サイキ", "カワノ", "カワハラ", "トミナガ",
+	"ヤスイ", "オオサキ", "スギタ", "スギウラ", "コンノ", "キクチ", "シモダ", "ヤマギシ", "キタムラ", "マルヤマ",
+	"イシダ", "クマガイ", "マツシマ", "シライシ", "オサダ", "オザワ", "オオタケ", "ゴトウ", "オダ", "アラヤ",
+	"ミタニ", "オザキ", "イワサキ", "カワムラ", "イグチ", "ヨコヤマ", "カネコ", "ミナミ", "オガワ", "ニシノ",
+	"トミナガ", "タケムラ", "ナカザワ", "ヤマギシ", "オオノ", "クナイ", "クハバラ", "シタムラ", "コガワ", "ツツイ",
+	"ホリエ", "イノウエ", "ソウマ", "ノザワ", "ウスイ", "イワタ", "オオヤマ", "ミヨシ", "ハヤカワ", "クハバラ",
+	"トミナガ", "カワグチ", "ノグチ", "ミヤシタ", "オオサキ", "カワノ", "タケナカ", "デグチ", "シバタ", "イケガミ",
+	"オガワ", "ムラマツ", "ミカミ", "ツノダ", "ニシノ", "モリモト", "イズミ", "シライ", "チバ", "ヤジマ",
+	"サイトウ", "タシマ", "アサイ", "ムラヤマ", "オガタ", "ナカタ", "オチアイ", "ナカシマ", "オオサキ", "ホリ",
+	"ミウラ", "クロサワ", "イシイ", "アラカワ", "ストウ", "オオノ", "シマダ", "カワノ", "ムラタ", "イワイ",
+	"ナカシマ", "カノウ", "ナカモト", "ミヤシタ", "オオクボ", "ナガイ", "ダイサキ", "ホシ", "アライ", "カン",
+	"タケダ", "オイカワ", "ヤギ", "オオキ", "オハラ", "カタオカ", "ムラカミ", "キタムラ", "ヨシハラ", "コマツ",
+	"コイズミ", "ハラタ", "ヤマサキ", "モリタ", "ニシムラ", "ムラセ", "コンドウ", "ニシノ", "タカセ", "オオイシ",
+	"ヤギ", "キシモト", "ヤマカワ", "キクチ", "カネギ", "ハラグチ", "オカムラ", "ミワ", "オオムラ", "ハヤシダ",
+	"コメタ", "ハタケヤマ", "ノダ", "コダマ", "イイダ", "ヒラオカ", "ナカヤマ", "オカ", "セト", "タニモト",
+	"サカイ", "フジイ", "ニシハラ", "カン", "ヒラタ", "コウムラ", "シバタ", "オオタケ", "カワノ", "コサカ",
+	"ナガシマ", "エグチ", "フジサワ", "チバ", "トミナガ", "マキノ", "マスダ", "カワムラ", "モチヅキ", "マスダ",
+	"クリタ", "オオニシ", "ニシ", "サイカワ", "コンドウ", "オカノ", "カタギリ", "フクハラ", "カワノ", "ナリタ",
+	"ハタケナカ", "サンヤ", "サイトウ", "カノウ", "フジムラ", "クロサワ", "イワイ", "アズマ", "ナカタニ", "オサダ",
+	"カワハラ", "キタノ", "ホシノ", "シライシ", "マツウラ", "アサノ", "コイケ", "ナカニシ", "オオイシ", "ミワ",
+	"イシカワ", "フクタ", "ウメタ", "サカタ", "コタニ", "クロカワ", "ツツイ", "クボタ", "オオシマ", "ニノミヤ",
+	"アラヤ", "ヨシハラ", "キシタ", "ダイジョウ", "ヒラヤマ", "ダイサキ", "アダチ", "マツムラ", "マツダ", "コモリ",
+	"タケモト", "キシモト", "コタニ", "キシモト", "ヒダカ", "カワサキ", "サカイ", "サイトウ", "サイキ", "コダマ",
+	"モテキ", "サイトウ", "ヤマナカ", "キシ", "シンタニ", "マスダ", "ハタケヤマ", "オカダ", "エンドウ", "タナベ",
+	"ナカムラ", "ミズノ", "ムラヤマ", "ヒグチ", "マツヤマ", "ヒガシ", "ミヤギ", "コタニ", "ヤスタ", "シライシ",
+	"シライシ", "オカノ", "トミタ", "ミウラ", "カシワギ", "ホンダ", "デグチ", "モリ", "ツノダ", "コウダ",
+	"ツチイ", "タハラ", "シマサキ", "コバヤシ", "フクモト", "オギノ", "アダチ", "アサイ", "トダ", "アキヤマ",
+	"トクナガ", "サクマ", "ミヤカワ", "タムラ", "ニシハラ", "コサカ", "フクオカ", "ハラグチ", "カワタ", "カワサキ",
+	"アライ", "クボタ", "スガハラ", "キタノ", "ヒラオカ", "ウエタ", "スミタ", "ヒロタ", "ナガタ", "ゴトウ",
+	"ヒダカ", "ナカザワ", "マツシタ", "コモリ", "オダ", "シバタ", "マツウラ", "イシイ", "アオヤギ", "ヤスイ",
+	"ノムラ", "タケナカ", "ミヤシタ", "ヤナギタ", "オガタ", "オカダ", "カネコ", "オウチ", "ヤスイ", "オカベ",
+	"ニシカワ", "オオノ", "カナイ", "ハヤシ", "カイ", "キシモト", "ゴトウ", "ヨシハラ", "ツツミ", "タケモト",
+	"マツモト", "ハナダ", "ハタケヤマ", "タニモト", "マツシマ", "カワハラ", "ホリカワ", "ツチイ", "ナカガワ", "ヨシノ",
+	"ヒノ", "シライシ", "ダイサキ", "ムラカミ", "サカイ", "キクチ", "カネコ", "トダ", "ヤマサキ", "ハマダ",
+	"オオヒラ", "キムラ", "ハヤシ", "アオキ", "オノデラ", "オオシマ", "クロカワ", "シンタニ", "ナカムラ", "タニカワ",
+	"コイデ", "クハバラ", "ムカイ", "カワハラ", "タカシマ", "コサカ", "キタハラ", "マイタ", "ホソカワ", "コンノ",
+	"イズミ", "ヨコタ", "ヤジマ", "イナバ", "ミヤギ", "カワノ", "ムラマツ", "クロキ", "オヤナギ", "ミウラ",
+	"タカキ", "モキ", "ツカモト", "サイキ", "ヒラタ", "フジノ", "サカタ", "ナイトウ", "ヨシダ", "クラタ",
+	"フジノ", "マエカワ", "ショウジ", "タケナカ", "マツムラ", "セト", "キタノ", "ナカノ", "カミムラ", "ナカシマ",
+	"カネタ", "シバタ", "キタノ", "オウチ", "ゴトウ", "ヤマギシ", "カワノ", "エンドウ", "オオウチ", "セキネ",
+	"ヨコタ", "ワカバヤシ", "キタカワ", "ハマサキ", "イナダ", "ミヤケ", "タシマ", "ストウ", "ニシハラ", "ヒラカワ",
+	"フクオカ", "ミズタニ", "ヤガワ", "ナカニシ", "ミタニ", "ツカダ", "コンドウ", "ナガシマ", "ミヤギ", "アイザワ",
+	"ウチヤマ", "ワダ", "シノハラ", "ハヤシダ", "ミキ", "シバタ", "オオクボ", "ミヤモト", "タカハシ", "イワサキ",
+	"キタジマ", "ニイタ", "オオツキ", "オダ", "ミヤザキ", "キシタ", "ヤマモト", "ショウジ", "ハットリ", "ヒガシ",
+	"マツサキ", "イイジマ", "モリカワ", "シムラ", "イワセ", "フジハラ", "ツカモト", "タケイ", "ノグチ", "シノダ",
+	"アマノ", "デグチ", "ウエタ", "オオタケ", "サワダ", "ナカハラ", "カナキ", "イワタ", "アダチ", "ヨシイ",
+	"ヤスイ", "ヨネタ", "ナガタ", "オガワ", "ニノミヤ", "シモダ", "ミタニ", "ヒグチ", "ホリウチ", "フクシマ",
+	"ニシザワ", "コガ", "ハマノ", "アライ", "ドイ", "ヒラオカ", "ヤマサキ", "トヨタ", "ミヤケ", "オハラ",
+	"ウエノ", "オオカワ", "タカキ", "ヨシザワ", "コンドウ", "ウスイ", "ニシザワ", "フジハラ", "シノダ", "ホツタ",
+	"ハヤカワ", "フクタ", "キクチ", "ヤマオカ", "ナガノ", "ヨコヤマ", "フジオカ", "イイダ", "ナガオ", "タナベ",
+	"ホリグチ", "オクタ", "ミヤバラ", "カワムラ", "ヒダカ", "ホツタ", "マツヤマ", "ニシザワ", "マツヤマ", "マツダ",
+	"モリモト", "シラカワ", "テヅカ", "ハヤカワ", "ヤマギシ", "ヨコタ", "マツオ", "ナガタ", "ホンダ", "ソウマ",
+	"ストウ", "テラダ", "スダ", "タグチ", "サカタ", "ストウ", "ツチイ", "オカムラ", "オオイシ", "ムラマツ",
+	"コダ", "イトウ", "フクイ", "トミタ", "ナガノ", "オクタ", "ムカイ", "ハマダ", "フクオカ", "オオウチ",
+	"シノダ", "ヒロタ", "ワダ", "ムラカミ", "ヤマオカ", "カナザワ", "シブタニ", "マツオカ", "タシマ", "ミヤケ",
+	"サカキハラ", "サカグチ", "カワノ", "ヤマウチ", "フクイ", "ササキ", "オオツカ", "ミヤザキ", "オクムラ", "ネモト",
+	"タシロ", "マキノ", "モリ", "クボ", "オオツキ", "ニワ", "ヨシダ", "サカタ", "ムラセ", "カトウ",
+	"フクオカ", "ヨシモト", "ウスイ", "ヤマグチ", "ハギワラ", "クボタ", "ヌマタ", "ミタニ", "ヤマウチ", "クロキ",
+	"ノザキ", "セキ", "フジオカ", "クロタ", "コガ", "クリハラ", "オカムラ", "アマノ", "ニノミヤ", "コウノ",
+	"ナカタニ", "シマダ", "セト", "イケガミ", "イシバシ", "ミヤカワ", "セキネ", "ヒガ", "サイトウ", "ホシノ",
+	"エンドウ", "オギノ", "ヨコタ", "カンノ", "ツジ", "フジサワ", "フジタ", "ホリカワ", "カワムラ", "アキタ",
+	"マツヤマ", "タカイ", "エンドウ", "シノダ", "アズマ", "カワタ", "ノナカ", "ヨシノ", "フジイ", "タムラ",
+	"スズキ", "カイ", "カイ", "サイトウ", "カナザワ", "シマダ", "オザワ", "コバヤシ", "オクヤマ", "フカサワ",
+	"カワモト", "オオムラ", "ムラカミ", "イマムラ", "オオタニ", "アキモト", "ムラセ", "モチヅキ", "オオモリ", "スガノ",
+	"コクラ", "ショウジ", "タムラ", "タケトウ", "ハラ", "ヨネタ", "ウメタ", "クリハラ", "カサイ", "セト",
+	"フジハラ", "カワムラ", "ヒノ", "ソノダ", "ミヤケ", "キクチ", "カワグチ", "カワタ", "ニワ", "ヤマギシ",
+	"スギウラ", "イマノ", "ニシオカ", "マチダ", "アオキ", "ナカニシ", "マツオカ", "サワダ", "ツルタ", "イナダ",
+	"ハマダ", "コンノ", "サカイ", "フジハラ", "ミヤモト", "ヒラカワ", "イマノ", "ハギワラ", "ホンマ", "ネモト",
+	"シマダ", "クナイ", "サイトウ", "オイカワ", "キタムラ", "ミウラ", "オオハシ", "シブタニ", "カタヤマ", "イシイ",
+	"コサカ", "イズミ", "オカモト", "ムラマツ", "ヨシカワ", "スミタ", "コマツ", "スダ", "コイケ", "アキヤマ",
+	"シマダ", "ナカヤマ", "イナガキ", "セト", "ニシモト", "ハマダ", "ノザキ", "イトウ", "オオカワ", "ヨシダ",
+	"ナカモト", "コンドウ", "ホリエ", "フクハラ", "コジマ", "コウダ", "オオタ", "フジカワ", "ナカシマ", "キタカワ",
+	"ナカザワ", "カサハラ", "サトウ", "アンドウ", "オオイシ", "イシヅカ", "カワモト", "コヤナギ", "クナイ", "ヤマグチ",
+	"フジカワ", "オオタニ", "タケシタ", "ホシノ", "ツチイ", "タケムラ", "ヤマカワ", "ミキ", "フルタニ", "クロキ",
+	"ツチヤ", "アオヤマ", "アサダ", "カミムラ", "オオクボ", "マツヤマ", "ナカノ", "コマツ", "シライ", "ヤスタ",
+	"ハヤカワ", "キタカワ", "ミタニ", "イケガミ", "アラカワ", "ニシオカ", "ワダ", "ノグチ", "イシイ", "オオツカ",
+	"カナダ", "コウダ", "ホンダ", "ウチウミ", "カミムラ", "ヤジマ", "ミズノ", "フクナガ", "イシザキ", "ヤスイ",
+	"ヤマシタ", "オオイシ", "オオニシ", "クロカワ", "クリタ", "サイカワ", "コイズミ", "カタオカ", "コモリ", "クマガイ",
+	"ナガイ", "トダ", "ハタケナカ", "ヤスイ", "ツカモト", "ヨコヤマ", "トミナガ", "タケモト", "ミヤバラ", "イワサキ",
+	"イグチ", "タカタ", "ナガタ", "ナガオカ", "ハシモト", "ハタケナカ", "カナダ", "イケダ", "イイダ", "シノサキ",
+	"フクオカ", "オガワ", "アベ", "ヨシムラ", "アベ", "キクチ", "カタギリ", "アライ", "ヨシカワ", "ヤマウチ",
+	"アオヤギ", "ハヤシダ", "ニシモト", "ナイトウ", "アサノ", "サトウ", "セキグチ", "ナガタ", "マツナガ", "ヒガ",
+	"タケダ", "コタニ", "ムラカミ", "ダイジョウ", "ハヤカワ", "ヒダカ", "トミナガ", "シマダ", "コマツ", "マツシタ",
+	"キタカワ", "オガタ", "アラヤ", "セキネ", "トミタ", "コイズミ", "タケダ", "アダチ", "オクヤマ", "シノダ",
+	"アンドウ", "カトウ", "カワイ", "アキモト", "ハタケヤマ", "ヤマシタ", "ウエノ", "マスダ", "ヒガシ", "コイデ",
+	"オノ", "ムカイ", "ツダ", "ヤマナカ", "イシイ", "セキ", "ノザワ", "モチヅキ", "オヤナギ", "カクタ",
+	"フジノ", "カミタニ", "スズキ", "ナカヤマ", "マエタ", "タカセ", "ミワ", "マツムラ", "ショウジ", "テラダ",
+	"コタニ", "コウダ", "マルヤマ", "カシワギ", "マキノ", "クハバラ", "コマツ", "セト", "ストウ", "ノグチ",
+	"オオサワ", "アオヤマ", "ニシ", "ヌマタ", "カナキ", "ヤマムラ", "イマムラ", "アサダ", "ナガシマ", "スギモト",
+	"ホリ", "ツノダ", "シノサキ", "センダ", "ダイジョウ", "カミタニ", "ヤギ", "マツダ", "サンギ", "オオツカ",
+	"マキノ", "クボタ", "カタギリ", "オヤマ", "ヒラタ", "ツツミ", "トクタ", "カワムラ", "ヒライ", "カナダ",
+	"ヨシカワ", "クドウ", "タケモト", "ヒライ", "サクライ", "ナリタ", "モキ", "クボ", "ニシハラ", "コウノ",
+	"カネギ", "コタニ", "センダ", "イマノ", "ヤマギシ", "フジサワ", "ナガサワ", "カタギリ", "ヒガ", "ノザワ",
+	"イシハラ", "ナリタ", "クボタ", "イシダ", "ムラタ", "タニカワ", "アラキ", "マチダ", "サカキハラ", "シライシ",
+	"ハタケナカ", "モチヅキ", "オオシマ", "ハットリ", "オノ", "フジハラ", "スミタ", "ヨシザワ", "シタムラ", "イマノ",
+	"ハマグチ", "イナバ", "スズキ", "イシクロ", "イイダ", "ヤマモト", "ナガノ", "ホリカワ", "モリタ", "コウノ",
+	"オギノ", "ナガシマ", "イノウエ", "サイカワ", "アズマ", "ハットリ", "オノ", "イワセ", "ツチイ", "タカノ",
+	"ノグチ", "タナカ", "アオヤマ", "ナガタ", "タカセ", "サイキ", "アラカワ", "ヒヨシ", "タカハシ", "オオニシ",
+	"ワタナベ", "ノグチ", "イマノ", "ヨシザワ", "ナカハラ", "アベ", "カネタ", "ショウジ", "タシロ", "キタムラ",
+	"タガミ", "オオツキ", "コウムラ", "ムラタ", "キタムラ", "モテキ", "カシワギ", "ニシモト", "コタニ", "クラタ",
+	"ミヤタ", "スギウラ", "ヒラヤマ", "クリハラ", "カナダ", "ツジ", "コイデ", "シモダ", "ミヤタ", "カワイ",
+	"オオツキ", "マツムラ", "ホソカワ", "ナガシマ", "カワムラ", "アベ", "フジタ", "コンドウ", "クロサワ", "ツチイ",
+	"モキ", "ミヤザワ", "カン", "サンギ", "イシバシ", "ヒグチ", "トクタ", "ササキ", "オオハシ", "シモダ",
+	"ヨコタ", "ヤマサキ", "イシザキ", "アイザワ", "タカヤマ", "クリタ", "カワムラ", "イナガキ", "タニグチ", "ナカムラ",
+	"マルヤマ", "ダイサキ", "イシザキ", "キタハラ", "エンドウ", "ハラタ", "アサダ", "コモリ", "タケシタ", "コクラ",
+	"ウスイ", "ヒロタ", "ストウ", "ノザキ", "ヤマオカ", "サクライ", "イトウ", "タケダ", "スダ", "カマタ",
+	"タナカ", "コダマ", "ツジ", "ウツミ", "ヤギ", "クハバラ", "オカダ", "ヨシカワ", "クロカワ", "ミヤモト",
+	"オオサキ", "ニシオカ", "タガミ", "ツノダ", "シマダ", "オクヤマ", "ワダ", "キタムラ", "ハラ", "シノハラ",
+	"タハラ", "ヤノ", "コウノ", "タシマ", "ハマダ", "カナダ", "ヨシカワ", "コンドウ", "マチダ", "コウノ",
+	"ニシタ", "タケウチ", "タガミ", "カワハラ", "タニモト", "オガワ", "ハラタ", "ナカシマ", "タカキ", "タウエ",
+	"タハラ", "ヨネタ", "クボタ", "オウチ", "カワサキ", "オオタ", "クロカワ", "イケダ", "マキノ", "オダ",
+	"オオウチ", "ヒガ", "イイジマ", "イシダ", "ツチヤ", "モリヤマ", "ソウマ", "トミタ", "ウチウミ", "アオヤマ",
+	"ニシムラ", "ナガオカ", "カタオカ", "キシモト", "ウエダ", "ウチダ", "ヤマシタ", "ミヤモト", "フジムラ", "ナガノ",
+	"トミタ", "アライ", "オオムラ", "ナガサワ", "ネギシ", "シライ", "オノデラ", "アサノ", "モテキ", "ハナダ",
+	"ホシ", "ウエハラ", "カノウ", "オクムラ", "クリハラ", "ニシムラ", "クハバラ", "カワノ", "カワハラ", "ヤマウチ",
+	"クナイ", "ダイジョウ", "オオハシ", "イシクロ", "サクライ", "コニシ", "イワセ", "ヨコヤマ", "コバヤシ", "フジノ",
+	"モリモト", "オクタ", "イトウ", "イケダ", "コハラ", "フルカワ", "シノサキ", "タニカワ", "コウダ", "クハバラ",
+	"ヒラカワ", "スダ", "コウノ", "カネタ", "コタニ", "コジマ", "テヅカ", "ヒグチ", "セキネ", "ヒラタ",
+	"シバタ", "イズミ", "シブタニ", "ホリカワ", "スズキ", "ホリウチ", "ニシオカ", "アマノ", "キクチ", "コウノ",
+	"ワタナベ", "カサイ", "オサダ", "サカイ", "オヤナギ", "コンドウ", "イイダ", "ホリグチ", "ハットリ", "ヤスイ",
+	"テヅカ", "ヨコタ", "ホンダ", "タケシタ", "キタカワ", "ヤマダ", "ヤノ", "ミナミ", "モリ", "フルタ",
+	"カタオカ", "ニシザワ", "セト", "カイ", "オオイシ", "ヤマモト", "ハラタ", "ハットリ", "ニシモト", "ヒラヤマ",
+	"ホリエ", "イケダ", "オノ", "キタムラ", "クボタ", "カミタニ", "カワサキ", "クロサワ", "コサカ", "イシイ",
+	"ニシカワ", "コウノ", "ドイ", "ミワ", "キクチ", "ソノダ", "ソノダ", "ツツイ", "コヤナギ", "マツノ",
+	"ミヤカワ", "フジノ", "コウノ", "フクナガ", "オグラ", "コニシ", "カナダ", "サイキ", "ヨシオカ", "フカサワ",
+	"サカグチ", "ウエムラ", "コヤマ", "ツチダ", "コヤナギ", "タカハシ", "コマツ", "イトウ", "シミズ", "ナガノ",
+	"ホンダ", "フジオカ", "ホリ", "サンヤ", "キクチ", "カネタ", "スギタ", "ハットリ", "タケダ", "ホシノ",
+	"イナバ", "アラヤ", "ヌマタ", "ハシモト", "ツダ", "ハラ", "トミタ", "ヒラカワ", "カトウ", "オカムラ",
+	"ヒノ", "ナカムラ", "ホリカワ", "ナカオ", "キタカワ", "マツウラ", "スガノ", "コヤナギ", "ホツタ", "マツオカ",
+	"フカサワ", "ヒヨシ", "ヤマネ", "トクナガ", "アダチ", "アオキ", "タナベ", "アベ", "ヒラタ", "ミヤカワ",
+	"マツムラ", "マツナガ", "ヤスイ", "カワノ", "カンノ", "オオタ", "エンドウ", "チバ", "タダ", "セト",
+	"ニシザワ", "キタカワ", "キタジマ", "カワグチ", "ヨコイ", "ヒラオカ", "アラキ", "イマイ", "オオタニ", "イトウ",
+	"シタムラ", "セキグチ", "ヤスイ", "アズマ", "ニシモト", "イイジマ", "イシハラ", "オザキ", "ハラタ", "デグチ",
+	"マルヤマ", "ツノダ", "ヨシカワ", "シタムラ", "フルタ", "ノグチ", "ナガシマ", "オオニシ", "ナカオ", "ニシムラ",
+	"ミヤバラ", "ナカガワ", "シバタ", "オザワ", "タカタ", "イシヅカ", "コンドウ", "カトウ", "ナイトウ", "ヒガシ",
+	"コウダ", "オオニシ", "タケダ", "イノウエ", "モリオカ", "ショウジ", "タケナカ", "シムラ", "ムライ", "オカモト",
+	"シモダ", "タケトウ", "キタカワ", "オオタニ", "イワモト", "サカイ", "コイケ", "ノザワ", "マツムラ", "マチダ",
+	"ハマグチ", "ヒラオカ", "トヨタ", "ナガサワ", "オカムラ", "フルカワ", "サワダ", "ヨシカワ", "タケモト", "ホリエ",
+	"シミズ", "イシカワ", "オダ", "スギウラ", "コマツ", "オカ", "ノグチ", "ソウマ", "クボ", "トダ",
+	"タケダ", "イシヤマ", "カンノ", "ホソカワ", "オウチ", "キタムラ", "カタオカ", "コンドウ", "サイカワ", "タケダ",
+	"カサハラ", "イワイ", "ゴトウ", "マツオ", "ニシタ", "タグチ", "カワムラ", "ツチダ", "ミヤギ", "ヒノ",
+	"カワノ", "ニワ", "オオサワ", "コウノ", "イマイ", "スギハラ", "ミキ", "オダ", "ソウマ", "イケガミ",
+	"イマイ", "ホソカワ", "ナガイ", "ニワ", "タグチ", "ハヤシダ", "ヤジマ", "カワバタ", "ニシムラ", "アオキ",
+	"タニモト", "ヨシハラ", "マツサキ", "イイダ", "ウエハラ", "ミナミ", "マイタ", "コクラ", "オオキ", "ナカヤマ",
+	"タシマ", "タグチ", "コイズミ", "コガ", "ハットリ", "イシバシ", "ホソカワ", "ヤマサキ", "イワタ", "ヒラカワ",
+	"オクムラ", "ツルタ", "イワセ", "トダ", "ノザキ", "トダ", "タナベ", "エグチ", "オオヒラ", "ミワ",
+	"カメイ", "コメタ", "ヤマダ", "ヨコイ", "ホリカワ", "ナガノ", "コダマ", "ニシタ", "タカキ", "ヒガ",
+	"ヤスイ", "ニイタ", "ナガノ", "ニイタ", "ヤノ", "ミナミ", "シマダ", "モリタ", "イワイ", "スギタ",
+	"ニシ", "ヒロセ", "カワハラ", "ヤガワ", "ノザキ", "ヒラマツ", "アキモト", "フクタ", "サワダ", "オオヤマ",
+	"ヒラノ", "イナバ", "タニモト", "ヒヨシ", "オオカワ", "ムカイ", "オオニシ", "ヨシダ", "コンドウ", "マツノ",
+	"タケモト", "タケウチ", "タケダ", "ミワ", "フジムラ", "ツルタ", "ナガオカ", "タナカ", "ヤスイ", "シブタニ",
+	"ヒライ", "タケダ", "ワタナベ", "ミヤウチ", "アサダ", "スガ", "ウチヤマ", "マツノ", "ナガタ", "タナカ",
+	"カワノ", "ナガタ", "キムラ", "マツシマ", "カン", "フルタニ", "ウエノ", "シモダ", "ババ", "ヨシムラ",
+	"ムライ", "フカサワ", "コメタ", "クリハラ", "オサダ", "ミヤザワ", "ノダ", "スギタ", "イシクロ", "オヤナギ",
+	"オグラ", "コイケ", "ヨシハラ", "タバタ", "ナカニシ", "ヨコタ", "ナカハラ", "タケモト", "カタヤマ", "ババ",
+	"タシマ", "タハラ", "オハラ", "オカムラ", "シラカワ", "オクヤマ", "フルカワ", "イシヅカ", "カトウ", "ナガノ",
+	"イケガミ", "ヤマダ", "カナイ", "ノナカ", "ミゾクチ", "ハヤシダ", "ノザワ", "ホソカワ", "ハラグチ", "ナカヤマ",
+	"ミヤタ", "イグチ", "ナガノ", "ミズタニ", "ミズノ", "キンジョウ", "オカザキ", "アベ", "ミヤウチ", "オクタ",
+	"クロキ", "ニワ", "セキグチ", "タガミ", "ナカガワ", "カミムラ", "タカハシ", "ナカムラ", "アマノ", "マイタ",
+	"タキサワ", "マエカワ", "テラダ", "イケダ", "ヒラタ", "サカタ", "ナカノ", "カナキ", "ダイジョウ", "カナダ",
+	"カシワギ", "ツジ", "ウノ", "コマツ", "サイトウ", "デグチ", "ナガサワ", "コウムラ", "ニシザワ", "ナガイ",
+	"サイトウ", "オオヤマ", "クマガイ", "ハギワラ", "ホツタ", "シマサキ", "スガノ", "ニシ", "サンヤ", "ナカニシ",
+	"ナガタ", "ヒラオカ", "オオキ", "ハマサキ", "ヤジマ", "フジノ", "ニシノ", "シライシ", "オサダ", "オオバ",
+	"ニワ", "フジムラ", "コンノ", "カワハラ", "ヤマカワ", "フジオカ", "アンドウ", "ノムラ", "オヤナギ", "ヤスイ",
+	"カナキ", "イノウエ", "ナカザワ", "オオカワ", "タケダ", "アンドウ", "ホソカワ", "モテキ", "サノ", "カワノ",
+	"ハヤシ", "カサハラ", "オサダ", "フジイ", "ミゾクチ", "コウノ", "クロタ", "コヤナギ", "ハナダ", "セキ",
+	"ナカニシ", "オザワ", "ミワ", "オクヤマ", "ドイ", "ムラカミ", "キシモト", "ナリタ", "サカキハラ", "タカヤマ",
+	"ホシ", "トヨタ", "カイ", "ヨネタ", "イマムラ", "アラヤ", "ヤナギタ", "ヤスタ", "コウムラ", "シムラ",
+	"ハマサキ", "イイジマ", "カワノ", "ウエハラ", "ミヤウチ", "イワセ", "ノムラ", "サイカワ", "タケナカ", "ウスイ",
+	"マツシマ", "アオヤギ", "オザキ", "タカハシ", "ナカムラ", "ササキ", "オノデラ", "スガノ", "ハタケナカ", "ヨシムラ",
+	"ヤマウチ", "イワイ", "イナダ", "キシモト", "フジムラ", "オノデラ", "ヤナギサワ", "ゴトウ", "シラカワ", "クドウ",
+	"フルタ", "タケダ", "フクハラ", "イワセ", "ホンダ", "ハットリ", "テラダ", "ナガイ", "ナカハラ", "ハタケナカ",
+	"コサカ", "ヨシオカ", "アライ", "ヨシハラ", "カワサキ", "オオカワ", "オオウチ", "アンドウ", "シムラ", "コジマ",
+	"ツダ", "タキサワ", "ハットリ", "シブタニ", "ヒガシ", "デグチ", "ホンダ", "アラキ", "カワサキ", "カジハラ",
+	"マツシマ", "キシ", "コウノ", "イイダ", "フルタ", "オノ", "ミヨシ", "シムラ", "オクヤマ", "クロカワ",
+	"ナカザワ", "オオツキ", "ネモト", "スガ", "キシ", "イノウエ", "ヨコヤマ", "サワダ", "ワタナベ", "スズキ",
+	"ドイ", "カトウ", "ムトウ", "アダチ", "ソノダ", "モテキ", "オオキ", "ヤノ", "ムラカミ", "テラダ",
+	"ホリグチ", "カサハラ", "キシモト", "オオキ", "カナダ", "ヨシザワ", "イイダ", "ヒヨシ", "ヒガ", "ナカオ",
+	"ミカミ", "ヤジマ", "エノモト", "イトウ", "ハヤシ", "ナイトウ", "イマムラ", "ヒラノ", "ナカハラ", "ノザワ",
+	"カトウ", "カクタ", "イケガミ", "ヨシイ", "カナイ", "ハマグチ", "ウエノ", "カマタ", "フジカワ", "モチヅキ",
+	"ヨシノ", "ウツミ", "オオタ", "マルヤマ", "フクイ", "アマノ", "アラヤ", "ハヤシダ", "ムラセ", "クラタ",
+	"コガワ", "クボ", "オサダ", "タカタ", "ツチイ", "カワバタ", "クドウ", "イワサキ", "ヤマネ", "セキネ",
+	"オサダ", "シラカワ", "オノデラ", "キタハラ", "アラタ", "イシクロ", "オイカワ", "アラカワ", "サイトウ", "トミタ",
+	"ヤマギシ", "ムカイ", "キタカワ", "カクタ", "ヤマモト", "フクオカ", "マツモト", "セキグチ", "コサカ", "クロタ",
+	"セキ", "ヨシザワ", "フジハラ", "ナガオ", "ムラカミ", "シノハラ", "ハヤシ", "タシロ", "シノハラ", "ヨネタ",
+	"コメタ", "サノ", "ムラカミ", "ハヤシ", "オサダ", "フジカワ", "オノデラ", "ミヤウチ", "フジムラ", "テラダ",
+	"アンドウ", "マツシマ", "フジノ", "トダ", "マキノ", "セキ", "ヌマタ", "マツウラ", "モテキ", "オカザキ",
+	"コジマ", "モテキ", "ミヤバラ", "ナガタ", "オクタ", "ウチウミ", "ババ", "ニシカワ", "アズマ", "ハタケヤマ",
+	"オオヤマ", "サンヤ", "イシクロ", "サカイ", "ツノダ", "ダイジョウ", "マツシマ", "ミナミ", "サカグチ", "ハマノ",
+	"スギヤマ", "カワノ", "ミヤギ", "カワタ", "カワシマ", "ヤジマ", "フクハラ", "タグチ", "オオニシ", "マツウラ",
+	"ホソカワ", "イシカワ", "ムラヤマ", "ナガイ", "オクヤマ", "ナガオ", "ホリグチ", "マスダ", "ツチヤ", "キタムラ",
+	"オノデラ", "ナガオカ", "カトウ", "ヨシハラ", "イナバ", "ナカイ", "ツツミ", "ムラマツ", "オオウチ", "サイカワ",
+	"タニグチ", "カミムラ", "センダ", "シマサキ", "イケダ", "ノナカ", "ツノダ", "フルタ", "マツシマ", "ミヤバラ",
+	"フジタ", "カワハラ", "フクタ", "トダ", "ゴトウ", "ツルタ", "ヨシハラ", "コジマ", "タカタ", "モキ",
+	"イノウエ", "モリカワ", "キクチ", "オオツカ", "ツツミ", "フジイ", "サンヤ", "ウエノ", "イズミ", "ワタナベ",
+	"ニシ", "ツノダ", "クリハラ", "トミタ", "ミズタニ", "ミズノ", "ヤマナカ", "ナガノ", "ヤガワ", "オオムラ",
+	"ハタケヤマ", "オクムラ", "ノザワ", "ミヤウチ", "ミズタニ", "フジカワ", "タハラ", "タバタ", "ミヤウチ", "シムラ",
+	"オガワ", "オオウチ", "ウエムラ", "オオイシ", "ナガイ", "モキ", "ナガタ", "オガタ", "コバヤシ", "ハギワラ",
+	"フクナガ", "コウノ", "コバヤシ", "サカモト", "タカノ", "イワイ", "ホンダ", "タウエ", "ホリエ", "フクモト",
+	"イシクロ", "ナガイ", "ハマノ", "コニシ", "アダチ", "ホシノ", "コガ", "イトウ", "ツチヤ", "ホリグチ",
+	"ニノミヤ", "オオシマ", "コモリ", "フカサワ", "オオキ", "ヤジマ", "モリ", "ノダ", "オオツカ", "カミムラ",
+	"ミヤケ", "ナカシマ", "オクヤマ", "ツチヤ", "クボ", "モキ", "ミキ", "コンドウ", "ヨコヤマ", "アダチ",
+	"オサダ", "カワモト", "イリエ", "オオムラ", "ヨシハラ", "ノムラ", "オカダ", "イナガキ", "スギモト", "シバタ",
+	"オギノ", "オイカワ", "ヒロタ", "モリカワ", "イワモト", "オカノ", "オハラ", "マエタ", "ツチダ", "コヤナギ",
+	"サカキハラ", "ナカニシ", "キクチ", "オカモト", "カワカミ", "ナガタ", "ツルタ", "ツツミ", "ヤスイ", "コウノ",
+	"ハヤシダ", "タニグチ", "ハヤシ", "ミヤシタ", "ヒグチ", "アダチ", "ヨコタ", "タケダ", "ヤマオカ", "ミヤギ",
+	"オザキ", "オオタニ", "スギハラ", "タニカワ", "ヨシカワ", "スギハラ", "フクオカ", "ハシモト", "ナガタ", "オクノ",
+	"ナカシマ", "ヒダカ", "ウチウミ", "オガタ", "イマムラ", "ヤマダ", "マツナガ", "ワタナベ", "タグチ", "シラカワ",
+	"フジタ", "イトウ", "キシタ", "クハバラ", "ハマグチ", "フクイ", "フジイ", "ホリ", "ニシヤマ", "オガタ",
+	"サイカワ", "ハラタ", "マルヤマ", "ヤマオカ", "オオツカ", "スギハラ", "コニシ", "ヨシオカ", "クボタ", "ヨシダ",
+	"ヒラオカ", "オカノ", "ドイ", "モチヅキ", "ホシ", "ミヤタ", "フジサワ", "オクヤマ", "ウノ", "ホツタ",
+	"ニシノ", "トミタ", "ヤナギタ", "サカグチ", "ミカミ", "ヤマサキ", "ナガシマ", "オオキ", "アオヤマ", "オオハラ",
+	"ソノダ", "オサダ", "カワムラ", "マツバラ", "ヤマカワ", "ヤマギシ", "イナバ", "タムラ", "イシクロ", "ハセガワ",
+	"ナガノ", "テラダ", "スギヤマ", "サイトウ", "ノダ", "ツジ", "ホリウチ", "アラタ", "サイトウ", "イシバシ",
+	"サクライ", "シブタニ", "オカムラ", "アダチ", "マツウラ", "クハバラ", "カミタニ", "オクムラ", "ヒロセ", "イワサキ",
+	"ゴトウ", "モリシタ", "コタニ", "クマガイ", "モリモト", "カシワギ", "ウチヤマ", "オオツカ", "ミヨシ", "ツチヤ",
+	"フクイ", "エグチ", "オノ", "マツノ", "ツジ", "ハヤシ", "アオヤマ", "マツオ", "ワタナベ", "オカモト",
+	"ホンマ", "イシイ", "ストウ", "エグチ", "イトウ", "ヒラマツ", "カワムラ", "ナカオ", "カン", "アラタ",
+	"ツジ", "タケシタ", "アマノ", "オヤマ", "スギヤマ", "オオウチ", "ヒヨシ", "ヤマサキ", "ムラセ", "オオヒラ",
+	"イワタ", "イワタ", "ミヤケ", "ツチイ", "ミタニ", "オオモリ", "カワムラ", "コイデ", "フジモト", "ヤスイ",
+	"オオヒラ", "シマサキ", "エンドウ", "ナカノ", "オオムラ", "ニシモト", "ウノ", "フジイ", "カンノ", "ヤスタ",
+	"タウエ", "ニシムラ", "オオハラ", "タケトウ", "イシダ", "ミゾクチ", "ハマグチ", "カワムラ", "オオキ", "ハヤシ",
+	"クボ", "タニモト", "ヒガシ", "タカセ", "ヤマモト", "コマツ", "タムラ", "オノデラ", "ナガオカ", "ウエハラ",
+	"ツカダ", "ストウ", "キクチ", "タニカワ", "カワモト", "スギハラ", "ツノダ", "ヤナギタ", "アダチ", "ヤマダ",
+	"オクムラ", "ヒダカ", "ツルタ", "クロカワ", "シライシ", "イトウ", "カトウ", "オクノ", "ヒグチ", "サノ",
+	"ミヤモト", "ホリカワ", "カナイ", "ツノダ", "ヒロセ", "ニシタ", "タハラ", "カワカミ", "ヌマタ", "カワバタ",
+	"スギウラ", "オガタ", "タケダ", "ミヤギ", "カワシマ", "ホンダ", "キムラ", "タシマ", "ヤマギシ", "オダ",
+	"タウエ", "オガサハラ", "ヤナギタ", "ミヤモト", "ヒラノ", "ヒダカ", "タケウチ", "ヤマグチ", "サカイ", "カワグチ",
+	"ミタニ", "イナバ", "ハマノ", "エグチ", "ウスイ", "アベ", "ヒラマツ", "イシクロ", "ヒラタ", "オオカワ",
+	"オオツキ", "スギモト", "カナイ", "シモダ", "ゴトウ", "ノダ", "ヨシイ", "ナカオ", "ヤスイ", "カワノ",
+	"ハヤカワ", "カタオカ", "ハナダ", "ハヤシ", "セキグチ", "クリタ", "タケダ", "ウスイ", "トヨタ", "セキネ",
+	"マイタ", "ミヤケ", "シンタニ", "カサハラ", "カノウ", "フジムラ", "セキ", "オグラ", "カワカミ", "ヤマウチ",
+	"ツルタ", "チバ", "タナベ", "ハヤシ", "ヒロセ", "オオモリ", "クラタ", "カタオカ", "タカノ", "クロタ",
+	"キタノ", "トミタ", "ムラカミ", "ヤナギサワ", "ミヤモト", "サワダ", "スダ", "タケダ", "ミゾクチ", "クボ",
+	"イナガキ", "ゴトウ", "ババ", "ヤマオカ", "タハラ", "ナカオ", "ノグチ", "セト", "フジモト", "ミワ",
+	"ノザワ", "イシダ", "ハマダ", "ヤジマ", "スギウラ", "イケガミ", "ヤマシタ", "コガワ", "オウチ", "ハマダ",
+	"シノサキ", "ノザワ", "ヒガ", "エノモト", "マツダ", "カンノ", "カネコ", "ヨシイ", "シノハラ", "マエタ",
+	"ヨネタ", "ツチダ", "ナカノ", "ヨシハラ", "ヤマギシ", "アベ", "イワモト", "カワノ", "トクナガ", "ホリカワ",
+	"ホツタ", "カメイ", "イワセ", "アオヤマ", "ノダ", "マツシタ", "ヨシオカ", "ウチダ", "タムラ", "オクヤマ",
+	"マツオ", "タカキ", "ナカイ", "クハバラ", "マキノ", "マスダ", "オノ", "ウメタ", "クラタ", "オヤナギ",
+	"カネコ", "イシハラ", "モリタ", "クドウ", "カミタニ", "オダ", "オカダ", "オオツカ", "スガ", "オカベ",
+	"サンヤ", "タニグチ", "ヒヨシ", "ナカイ", "フジハラ", "フジノ", "ニワ", "ヤマダ", "オカ", "ハラグチ",
+	"カサハラ", "デグチ", "カサイ", "ウエハラ", "ムラカミ", "タケシタ", "フジイ", "フジハラ", "タカヤマ", "セキグチ",
+	"ハマサキ", "イトウ", "ヤマギシ", "マツモト", "イマノ", "ムラヤマ", "ヒヨシ", "オサダ", "イナバ", "ナカハラ",
+	"ホンマ", "オクノ", "ニシタ", "オオモリ", "ソノダ", "ツツミ", "ムラヤマ", "オガサハラ", "ヨシダ", "ヒダカ",
+	"イシヤマ", "ウチヤマ", "オカノ", "ニワ", "サイトウ", "トクタ", "イイダ", "ハシモト", "ミヤザキ", "コハラ",
+	"カン", "ナガタ", "ウエダ", "ハラグチ", "タニカワ", "シマダ", "コマツ", "アンドウ", "ムライ", "タケムラ",
+	"ナカガワ", "ハマダ", "ヤマモト", "オオモリ", "カワサキ", "オヤマ", "オクノ", "オノデラ", "オヤナギ", "ミズタニ",
+	"タダ", "ヤマシタ", "ハマグチ", "セキネ", "カネコ", "カワシマ", "ウエノ", "イグチ", "ヒラマツ", "ホンダ",
+	"コモリ", "フルタ", "マルヤマ", "カトウ", "ムライ", "ハマダ", "ノザワ", "キンジョウ", "マツサキ", "ソノダ",
+	"タダ", "オガサハラ", "ミヨシ", "トミタ", "サンギ", "ヤギ", "イナダ", "ヤマネ", "コヤナギ", "ナガイ",
+	"スギヤマ", "モリオカ", "コメタ", "スギタ", "タケトウ", "カネコ", "タニモト", "ハラ", "コメタ", "オガワ",
+	"オオタ", "ホンダ", "マキノ", "シモダ", "コバヤシ", "サトウ", "フジオカ", "ハマサキ", "ヒノ", "アベ",
+	"カノウ", "コバヤシ", "カワタ", "ヤギ", "ミキ", "タナベ", "カミムラ", "イシカワ", "フクオカ", "フルタ",
+	"モリモト", "イイジマ", "シミズ", "ソノダ", "エグチ", "イイジマ", "アサダ", "フカサワ", "ドイ", "コダマ",
+	"イシイ", "ヒダカ", "イケダ", "タケダ", "ミヤザワ", "アライ", "ホシ", "ウエノ", "シラカワ", "シミズ",
+	"ハギワラ", "アラキ", "カン", "マツムラ", "ユアサ", "コジマ", "オカベ", "ノザワ", "ミヤギ", "ヤマネ",
+	"イトウ", "オザキ", "ウチウミ", "ヨシザワ", "フジカワ", "ホリ", "イシダ", "フジカワ", "ニシオカ", "ニシハラ",
+	"ミヤザワ", "コヤナギ", "タグチ", "ツカダ", "ヨシカワ", "ウスイ", "スガハラ", "ナリタ", "ヤナギタ", "フルタ",
+	"ニシモト", "トミナガ", "アライ", "テラダ", "マツヤマ", "オハラ", "イリエ", "キタムラ", "ヤナギタ", "カワムラ",
+	"ヒヨシ", "イケダ", "シマダ", "スギヤマ", "タシロ", "ムラカミ", "イワイ", "エグチ", "イシクロ", "ニシハラ",
+	"ヤマシタ", "ナガノ", "ツチヤ", "ナイトウ", "オオイシ", "コモリ", "ナリタ", "ホリグチ", "ミヤシタ", "ナガノ",
+	"イトウ", "ヨシモト", "カタヤマ", "ツカモト", "ヤジマ", "カミタニ", "マスダ", "シラカワ", "オグラ", "ノザワ",
+	"タケトウ", "オオツカ", "ノダ", "ミナミ", "オオムラ", "ハラグチ", "タキサワ", "コンノ", "ヒライ", "マツナガ",
+	"オカダ", "ミヤバラ", "カワグチ", "シバタ", "オノ", "ヨコヤマ", "コガ", "ミヤザワ", "スギハラ", "タニ",
+	"オオハラ", "フクシマ", "ソノダ", "ニノミヤ", "キシ", "タハラ", "ナカノ", "カワモト", "タケムラ", "シライ",
+	"ダイサキ", "ニシモト", "カミタニ", "サカモト", "タニグチ", "キタカワ", "コウノ", "ヤマモト", "クロカワ", "ニシハラ",
+	"ヤナギサワ", "イナダ", "オノデラ", "オカダ", "アオヤギ", "ミヤケ", "ヒラオカ", "アンドウ", "オオキ", "イシヅカ",
+	"ナガイ", "ムカイ", "コウダ", "コダマ", "オサダ", "タンバ", "タケトウ", "フクナガ", "オヤマ", "タニグチ",
+	"スガハラ", "ヒガ", "アオヤギ", "タケナカ", "カワシマ", "ムライ", "ウスイ", "コハラ", "アベ", "フジタ",
+	"イリエ", "ウエダ", "オクヤマ", "オダ", "カワハラ", "セト", "ニワ", "ハマダ", "カワハラ", "サイキ",
+	"タハラ", "シラカワ", "フルタニ", "イシイ", "ネギシ", "モリタ", "マツムラ", "コガワ", "コニシ", "シマダ",
+	"イチカワ", "アキモト", "カサハラ", "オクヤマ", "ナカノ", "オダ", "ナガノ", "タウエ", "タニ", "カワハラ",
+	"オカモト", "スギモト", "コマツ", "タカマツ", "スギハラ", "ネモト", "シミズ", "サカグチ", "マツイ", "カナダ",
+	"ノムラ", "ハットリ", "イワタ", "フクイ", "ナカニシ", "トダ", "ムラマツ", "ヤマムラ", "ノグチ", "オカムラ",
+	"セキ", "カワサキ", "ミヨシ", "ヨシムラ", "コダ", "ヒロセ", "イイダ", "ヤギ", "ワタナベ", "マチダ",
+	"マイタ", "コニシ", "カマタ", "ヒラタ", "スガノ", "カワグチ", "ツツミ", "オオムラ", "マツオカ", "ツカダ",
+	"イケダ", "テヅカ", "ナカザワ", "トミタ", "ヨシダ", "ヒガ", "ヤノ", "クロカワ", "アラヤ", "タカマツ",
+	"ヤマネ", "カワノ", "フジムラ", "カネギ", "ダイジョウ", "オヤマ", "ツカダ", "アラヤ", "タケイ", "コガワ",
+	"ノムラ", "オカモト", "ツジ", "タダ", "ヒガシ", "ムラヤマ", "ナカタ", "アサノ", "フジカワ", "スガハラ",
+	"ウスイ", "ヒロタ", "ハシモト", "ウスイ", "タカタ", "キムラ", "イシヅカ", "ヤマナカ", "イワタ", "モリモト",
+	"タシマ", "ヤマムラ", "ツカダ", "ウチウミ", "アダチ", "サイトウ", "タカノ", "クリタ", "キムラ", "ミナミ",
+	"ショウジ", "タハラ", "コモリ", "ツダ", "イイツカ", "スガハラ", "ツルタ", "ナカモト", "コンドウ", "イケダ",
+	"ドイ", "ミヤザワ", "ツツイ", "ハラ", "ダイジョウ", "ショウジ", "キシモト", "スダ", "キムラ", "ヒラノ",
+	"シンタニ", "ヤマカワ", "ハマダ", "カワイ", "クリハラ", "スガハラ", "キシ", "ミズタニ", "ナカザワ", "ヤマナカ",
+	"ミヤタ", "イケダ", "ウチウミ", "オオキ", "マルヤマ", "イマノ", "イカラシ", "オオタ", "ヤマオカ", "ツルタ",
+	"ノナカ", "オオイシ", "タケナカ", "ヤマギシ", "オノ", "イリエ", "コガワ", "オオヒラ", "オガサハラ", "センダ",
+	"ダイジョウ", "ミズタニ", "カメイ", "シラカワ", "ナカモト", "ウエダ", "タケトウ", "ヒライ", "マツムラ", "イシハラ",
+	"サンヤ", "ナガオカ", "カミタニ", "センダ", "オガサハラ", "ヒラマツ", "タケダ", "カシワギ", "シノハラ", "タケトウ",
+	"シマダ", "オノ", "シムラ", "ナリタ", "センダ", "フクモト", "タカイ", "アラヤ", "オザワ", "コウダ",
+	"ウエムラ", "タケダ", "イシクロ", "サンヤ", "タカシマ", "ニシヤマ", "ノザワ", "カメイ", "サイトウ", "カワシマ",
+	"オクヤマ", "ナガオ", "ツチイ", "スギウラ", "ハタケヤマ", "フクナガ", "ノダ", "マツシタ", "マツナガ", "ヤガワ",
+	"ナカザワ", "タカハシ", "ヒヨシ", "オオカワ", "サトウ", "スギウラ", "クリタ", "ワダ", "ウエダ", "イチカワ",
+	"イズミ", "カサハラ", "ハラ", "ナガタ", "セキネ", "オカベ", "タケムラ", "クロタ", "ミワ", "コンノ",
+	"アズマ", "クハバラ", "ババ", "オガタ", "ナカオ", "ナカハラ", "ニシ", "ヒラタ", "イケダ", "ヨシオカ",
+	"マツイ", "タカハシ", "センダ", "マツナガ", "オカムラ", "ヒライ", "カンノ", "ハヤシ", "タケウチ", "ウスイ",
+	"オヤマ", "ネギシ", "ホソカワ", "ミヤザキ", "ニシノ", "カンダ", "カタギリ", "タグチ", "オウチ", "セキ",
+	"ホリカワ", "ツツイ", "ナカノ", "サカグチ", "ナガオカ", "オザワ", "オクタ", "アオヤギ", "キシタ", "アベ",
+	"ツツイ", "スズキ", "キンジョウ", "オクムラ", "ヒライ", "フカサワ", "モリオカ", "サクマ", "ヒヨシ", "ミナミ",
+	"サイトウ", "ヒラオカ", "サノ", "カサイ", "ハラグチ", "シタムラ", "コタニ", "ナカイ", "ワカバヤシ", "ヤスタ",
+	"セト", "シノサキ", "ウスイ", "シライ", "ツツイ", "スギウラ", "サトウ", "サカモト", "タカシマ", "タダ",
+	"ワダ", "コンノ", "クロカワ", "コウムラ", "ミズノ", "トクナガ", "イナガキ", "ヨシダ", "ニシカワ", "アズマ",
+	"モテキ", "ムラヤマ", "ヤマムラ", "サクマ", "タカノ", "トクタ", "タカハシ", "クリハラ", "サイキ", "タカノ",
+	"ウエダ", "ヤマカワ", "ヤガワ", "ナガノ", "ムライ", "トヨタ", "ホリ", "サカイ", "ニワ", "オオヒラ",
+	"アオヤマ", "ワダ", "ミヨシ", "タキサワ", "アサノ", "クボタ", "ナカタニ", "オオニシ", "タガミ", "イケダ",
+	"オカベ", "イイツカ", "ヤマダ", "ニシ", "フジイ", "ミヤギ", "ヒラカワ", "オオカワ", "カナダ", "ウエムラ",
+	"キタノ", "イシハラ", "ヤマシタ", "シンタニ", "ヒグチ", "アライ", "ナカニシ", "アラカワ", "ハラタ", "カワノ",
+	"ヤマシタ", "ウチダ", "ミヤタ", "ナカモト", "スガ", "フジハラ", "イシバシ", "ヒラノ", "カサイ", "オウチ",
+	"ヤスタ", "ヤマグチ", "クロタ", "タウエ", "オオツカ", "タウエ", "オカムラ", "カノウ", "コガ", "ニシザワ",
+	"サイトウ", "タカタ", "シノダ", "コジマ", "ヤマグチ", "フルタ", "ナカニシ", "モチヅキ", "アベ", "ヤマグチ",
+	"ムカイ", "キタノ", "ヨシオカ", "マイタ", "ヤナギサワ", "イシバシ", "ウチウミ", "クロタ", "ミヤザワ", "イマムラ",
+	"イシヤマ", "ミワ", "ウエムラ", "イケガミ", "イシイ", "イイダ", "カタオカ", "シミズ", "オヤマ", "スギモト",
+	"サカイ", "ヒラマツ", "ノザワ", "ダイジョウ", "ナカハラ", "ムラヤマ", "マエタ", "ナガノ", "サイトウ", "コマツ",
+	"ミゾクチ", "タニグチ", "ムラセ", "クロキ", "イワモト", "コガ", "タニグチ", "コヤナギ", "ヨシムラ", "クロサワ",
+	"ミヤウチ", "タシロ", "ツジ", "タカシマ", "ヤマカワ", "サイトウ", "ヒラマツ", "タバタ", "ヤマカワ", "ホリウチ",
+	"イリエ", "ヨシダ", "イトウ", "キタノ", "マツシマ", "ササキ", "カタオカ", "シノサキ", "トミタ", "キムラ",
+	"ニワ", "シモダ", "オオハラ", "シモダ", "アサイ", "オオタケ", "ナカタニ", "ナガオ", "イシイ", "ミタニ",
+	"サワダ", "カタギリ", "ツチイ", "ナガノ", "ナカハラ", "ヤマモト", "シンタニ", "サカイ", "カワノ", "タムラ",
+	"ナカオ", "オオウチ", "コハラ", "ミヤモト", "イナガキ", "ムラヤマ", "ツチイ", "オカザキ", "オギノ", "サンギ",
+	"カン", "ヒラヤマ", "セキ", "ヨコヤマ", "ノザワ", "ヒノ", "ニシムラ", "サワダ", "タニ", "シバタ",
+	"トクナガ", "フクモト", "イワモト", "クロサワ", "タカシマ", "オクタ", "コウノ", "オクヤマ", "ヒラカワ", "ホリエ",
+	"オグラ", "シライ", "オチアイ", "タンバ", "モリタ", "ホツタ", "カクタ", "タガミ", "ワダ", "マツオ",
+	"ナカモト", "カワモト", "ホシ", "ミヤカワ", "コイズミ", "オオツカ", "カワハラ", "ヤジマ", "ワタナベ", "ヒラノ",
+	"ゴトウ", "クマガイ", "タナベ", "ウチヤマ", "フルタ", "クハバラ", "カワムラ", "クボ", "ヤマグチ", "ナカタニ",
+	"キムラ", "ソノダ", "ナカノ", "ミヤザキ", "モキ", "タニグチ", "ハヤカワ", "タカイ", "オノ", "カワハラ",
+	"ナカタ", "フクシマ", "タダ", "カン", "コイケ", "アサダ", "イワサキ", "ナガオカ", "オオウチ", "ハマサキ",
+	"オオムラ", "ハラタ", "ヨシムラ", "ナガタ", "アライ", "フクモト", "アラタ", "オガタ", "マツバラ", "ハギワラ",
+	"ハタケナカ", "スミタ", "コンノ", "イシザキ", "シブタニ", "タケナカ", "カワハラ", "ナカモト", "ヨシカワ", "タケモト",
+	"ムトウ", "コマツ", "フカサワ", "ムラヤマ", "キクチ", "フルタニ", "ウエタ", "ナカザワ", "カナダ", "ヤガワ",
+	"ヤスイ", "カナキ", "ヤマムラ", "ヤナギタ", "キタカワ", "エノモト", "ハットリ", "ムラタ", "セキグチ", "スガハラ",
+	"ホツタ", "カネコ", "アキモト", "タカハシ", "モリ", "ムラマツ", "ツルタ", "ソウマ", "オダ", "コメタ",
+	"カワイ", "フカサワ", "オクヤマ", "ムカイ", "ハヤシダ", "サクライ", "オオバ", "トミタ", "イシザキ", "ミタニ",
+	"ウエムラ", "オカザキ", "キクチ", "ヒノ", "ツチヤ", "ヌマタ", "タグチ", "ミヤザキ", "タダ", "オチアイ",
+	"オガサハラ", "イイジマ", "ヤスタ", "クロカワ", "ハナダ", "タバタ", "カワイ", "アベ", "イケガミ", "ナカガワ",
+	"フルタニ", "カミムラ", "イマイ", "トクナガ", "シンタニ", "エンドウ", "フジムラ", "カワモト", "カンダ", "オオバ",
+	"カサイ", "サイカワ", "ハタケヤマ", "タケムラ", "フジモト", "サンヤ", "モリシタ", "ニシオ", "ヨシザワ", "クラタ",
+	"フクオカ", "ナリタ", "カワハラ", "キクチ", "ワタナベ", "ヤマシタ", "ヒノ", "オオノ", "オオイシ", "オハラ",
+	"モリモト", "タカセ", "ハセガワ", "コウダ", "カワノ", "マエタ", "マツモト", "コウノ", "コイズミ", "キタハラ",
+	"タバタ", "エノモト", "ヨシカワ", "サンヤ", "ムライ", "コダ", "シタムラ", "マツオカ", "トミナガ", "タカキ",
+	"マキノ", "カワノ", "ニシノ", "タニ", "アオヤギ", "スズキ", "コハラ", "ハラタ", "コウノ", "カナキ",
+	"タニカワ", "ナガシマ", "コイズミ", "ババ", "ムライ", "ホリウチ", "イマノ", "ホシ", "トミタ", "タウエ",
+	"オオカワ", "ワタナベ", "ウツミ", "シンタニ", "モリシタ", "フルタニ", "ササキ", "ナカシマ", "ホンダ", "スギタ",
+	"タンバ", "イナダ", "オオタニ", "ヨシムラ", "アオヤギ", "コメタ", "イシカワ", "ニシタ", "タカハシ", "ミヤカワ",
+	"カミムラ", "ヤマモト", "キクチ", "キタジマ", "イケガミ", "マツノ", "オギノ", "イケガミ", "シライ", "キクチ",
+	"マチダ", "ノダ", "タハラ", "ウチウミ", "カイ", "タカセ", "タカシマ", "フジカワ", "カワイ", "ナカハラ",
+	"タケナカ", "マツダ", "ホンダ", "ヒノ", "キタカワ", "ハナダ", "ニシタ", "フクナガ", "サンヤ", "サイトウ",
+	"オオキ", "ウエムラ", "ウノ", "マツイ", "オクノ", "モテキ", "フジムラ", "アライ", "ムカイ", "カンダ",
+	"シライシ", "サカキハラ", "ナガオ", "ヨシムラ", "カワノ", "クボ", "クナイ", "コイズミ", "オオツキ", "アキモト",
+	"コニシ", "ヤマナカ", "ナカニシ", "コンノ", "ミタニ", "ミヤケ", "イトウ", "クナイ", "サンギ", "セト",
+	"イイダ", "ダイサキ", "ヤスイ", "テヅカ", "ネギシ", "コニシ", "オガサハラ", "サイカワ", "クロカワ", "ヨコヤマ",
+	"タニモト", "コサカ", "モリヤマ", "ミゾクチ", "コニシ", "カナイ", "シバタ", "ミキ", "キタジマ", "タカシマ",
+	"モリヤマ", "ミタニ", "キシタ", "タカタ", "タグチ", "カナイ", "オカダ", "コイケ", "ミヤシタ", "サクマ",
+	"フクモト", "ミヤカワ", "ミヤシタ", "サワダ", "クラタ", "カワノ", "スギモト", "タケウチ", "ノザキ", "キタノ",
+	"アズマ", "ハマダ", "マツシマ", "セキネ", "カワハラ", "ワダ", "カナダ", "テヅカ", "タナカ", "オオウチ",
+	"カワイ", "ヒヨシ", "ムラマツ", "オオキ", "サカタ", "コメタ", "エグチ", "イグチ", "アラヤ", "ユアサ",
+	"フジカワ", "オオムラ", "マツナガ", "フクハラ", "サイトウ", "ハヤシ", "スギウラ", "アベ", "ニシオ", "ストウ",
+	"ミキ", "シライシ", "トダ", "アライ", "カワノ", "シラカワ", "キタノ", "オカ", "ヤマモト", "タカノ",
+	"アキモト", "タウエ", "オオタ", "キタノ", "オチアイ", "フクシマ", "モリオカ", "タニモト", "イカラシ", "タケウチ",
+	"ニシヤマ", "ナガタ", "シタムラ", "カジハラ", "ヤマシタ", "ナカタニ", "ホシ", "スズキ", "フクモト", "クドウ",
+	"オイカワ", "タケトウ", "タシロ", "フクタ", "ヤギ", "オオタニ", "スミタ", "マツダ", "オカノ", "オオバ",
+	"ミナミ", "ワタナベ", "オヤマ", "カワシマ", "ヤマウチ", "ツジ", "ニシヤマ", "センダ", "オオサワ", "マツイ",
+	"ヤマネ", "アマノ", "フジモト", "アズマ", "ツダ", "ウエハラ", "スギヤマ", "ホリグチ", "キンジョウ", "コウノ",
+	"オカモト", "アラキ", "ヨシムラ", "ムラタ", "イシハラ", "サンギ", "ウエノ", "ヒガシ", "タカイ", "ニノミヤ",
+	"ヤマモト", "ミヤタ", "オオタ", "トクナガ", "オオニシ", "コンノ", "スミタ", "モテキ", "ヤマギシ", "フルカワ",
+	"ヨシザワ", "オオサキ", "キシタ", "ナカシマ", "オチアイ", "ハタケナカ", "ヒライ", "タカヤマ", "イシハラ", "タカヤマ",
+	"ヤスイ", "ナカオ", "アズマ", "ナカタ", "サンギ", "ムライ", "ノザワ", "ハタケナカ", "オオタニ", "ヤスイ",
+	"ワタナベ", "エンドウ", "クロサワ", "トクナガ", "カナダ", "オカノ", "ナガイ", "ナカヤマ", "モテキ", "ニシ",
+	"アベ", "ネモト", "ハマグチ", "ムラヤマ", "サイトウ", "オクノ", "ハギワラ", "ハシモト", "クリタ", "ニシカワ",
+	"ハヤシダ", "ヨコタ", "キシタ", "アダチ", "イシカワ", "ナガタ", "ヤジマ", "カワサキ", "タムラ", "スギモト",
+	"オチアイ", "タケダ", "ナカノ", "コダ", "サンギ", "タカキ", "デグチ", "スミタ", "ヒノ", "アキヤマ",
+	"イリエ", "ムラタ", "ミカミ", "ナカオ", "カワムラ", "アサノ", "ヒノ", "イリエ", "キシ", "ミズノ",
+	"ウスイ", "タケモト", "オオタニ", "アンドウ", "ナガサワ", "アオヤマ", "ヒラノ", "マツオカ", "カマタ", "ホソカワ",
+	"イシヤマ", "テラダ", "オカムラ", "ウツミ", "ヤガワ", "ノザワ", "スズキ", "カワムラ", "オサダ", "アベ",
+}
+
+// 投票者の名
+var firstNames = []string{
+	"トシロウ", "ノリアキ", "マサタカ", "ミツヨシ", "ヤスヒデ", "ミチ", "ミチタカ", "アイ", "タイゾウ", "マキ",
+	"ゲンペイ", "ヒデユキ", "テイジ", "クニエ", "ミサト", "スイセン", "トモコ", "ヨシノリ", "マキ", "サヨコ",
+	"ユカ", "トモコ", "ハルジ", "サダユキ", "ヨシナオ", "トモ", "ミサト", "ヒサエ", "シゲトシ", "レイナ",
+	"トヨアキ", "タダスケ", "ヒサノリ", "マキ", "ミツオ", "ヒロマサ", "ケイキチ", "カズシゲ", "サヤカ", "サジュウロウ",
+	"アヤコ", "ケンイチ", "ヨシオ", "エツコ", "レイナ", "ミサト", "スイセン", "ヤスヒロ", "フミオ", "ハジメ",
+	"テルマサ", "マコト", "トシミ", "ヒトキ", "ノブエ", "アキコ", "ヨシエ", "シュンロウ", "エリ", "ケイスケ",
+	"サヤカ", "エイゴ", "テルマサ", "サダジ", "シゲヨシ", "ヒロエ", "ミキオ", "ミチヨシ", "タカヤ", "シン",
+	"シゲツグ", "カオリ", "サヨコ", "ミツオ", "ヒロハル", "カンイチ", "マサヒコ", "ヨシオ", "ユウシロウ", "ミツエ",
+	"マキ", "トモ", "サユミ", "ユイ", "ヨウジ", "タキコ", "クラミ", "ヒサエ", "ミキ", "テルコ",
+	"キヨ", "マリ", "スエタカ", "マキ", "レイ", "マサアキ", "ユキオ", "ヤスユキ", "テルコ", "ヨシナオ",
+	"スミ", "ヨシヤ", "タカオ", "トモヨシ", "ユキト", "ユウタロウ", "ツネユキ", "カツユキ", "ナミ", "シン",
+	"リョウジ", "ヨウジ", "ツギオ", "ヤスコ", "ミチオ", "ミキ", "チョウイチロウ", "キョウコ", "ケイ", "サダオ",
+	"ケイタロウ", "ミチヒコ", "ユキコ", "ヤスノリ", "レイナ", "マサオ", "ヨシノリ", "ナミ", "ヒサノリ", "タツオ",
+	"ヤスヒロ", "ユウキ", "ハナヨ", "アツシ", "シン", "リカ", "コウゾウ", "ムツオ", "トヨアキ", "ツバサ",
+	"ヤスヒデ", "ヒロト", "シンヤ", "マサヨシ", "ヨウノスケ", "ミオ", "リョウジ", "ヨウコ", "キョウジ", "ウンキチ",
+	"シンタロウ", "スエタカ", "ゼンジ", "マコト", "シゲノブ", "コウザブロウ", "ナツミ", "ユウコ", "レイコ", "ノリヒコ",
+	"トモ", "コウイチロウ", "シゲミ", "リョウイチ", "レイイチ", "マサチカ", "ユイ", "リョウヤ", "カズノリ", "トシロウ",
+	"ソウスケ", "ヒビキ", "ミチ", "ユキコ", "キヨタカ", "ナオミ", "ハナヨ", "ナオミ", "ヨシヒロ", "ヨウスケ",
+	"タカヒコ", "ユウジ", "タダヒロ", "ユキヒロ", "チヨエ", "キヨシゲ", "リョウイチ", "トモコ", "トシノリ", "ヒロシゲ",
+	"アツヤ", "シゲヨシ", "シュンロウ", "ミツエ", "ハルノ", "アキミ", "ヒデノリ", "セイゴ", "ミエコ", "リエ",
+	"ヨシノブ", "クニヨシ", "アヤコ", "エツコ", "ケンジ", "タケイチ", "ミホコ", "カツト", "ヨシタケ", "マサヤス",
+	"ナガオ", "テツアキ", "ヤスノブ", "マチコ", "ユウカ", "コウジ", "ヨウノスケ", "マサル", "ヤスタミ", "テルヤ",
+	"コウジ", "タクジ", "ヨシマサ", "トモハル", "ミツオ", "テルコ", "テツコ", "ヨシチカ", "タカシ", "ヨシヤ",
+	"コウイチ", "カメオ", "レイ", "イクオ", "トモヨシ", "ヨシエ", "スミタカ", "ナミコ", "リエ", "ノリオ",
+	"カオリ", "シゲイチ", "サトル", "シゲキ", "シゲノブ", "ハルヒト", "ジュンロウ", "マサヒロ", "コウシロウ", "タツオ",
+	"エミ", "レイコ", "ヨシマサ", "ミヨ", "ヨウイチロウ", "ウメタロウ", "シゲフミ", "ヤスコ", "ギンノスケ", "コウゾウ",
+	"ゲンザブロウ", "ヒデユキ", "トモエ", "ユウコ", "ユウコ", "ヤスゾウ", "セイシロウ", "ヨシヒロ", "ジュンジ", "トシミツ",
+	"ミハル", "トシヒト", "ヒロヤス", "ルリコ", "ブンゴ", "マサエ", "ヤスヒロ", "ユキ", "ケイ", "ヨシオ",
+	"トモ", "タダヒロ", "キミオ", "キュウサク", "ヤスノブ", "イッセイ", "ユウキ", "モモヨ", "ヒロム", "ミオ",
+	"タヅコ", "シナ", "ユウシロウ", "フミノリ", "ユリ", "ノブコ", "タダアキ", "ケンゾウ", "タツオ", "キヨノブ",
+	"アイ", "トモミ", "ハナヨ", "ギンノスケ", "ユウコウ", "ヨシオ", "ヨシマサ", "マサノリ", "コウジ", "タカジ",
+	"ユウカ", "アキオ", "テルヤ", "タダヒロ", "サンペイ", "コウタロウ", "ヒサミ", "ヨシカズ", "マキ", "サトル",
+	"アキヨ", "マサミ", "エイジュ", "タクオ", "キヨシゲ", "マサキ", "ケイタロウ", "キヨシゲ", "マサヒロ", "ヒロエ",
+	"シンイチ", "マサヒロ", "クニヒロ", "ミツエ", "マキ", "ヨシツグ", "シゲオ", "イクミ", "ヒロユキ", "キョウコ",
+	"タカシ", "ノブコ", "トモエ", "トモエ", "ユウカ", "アリカ", "テルヤ", "ミノブ", "ナミ", "ジュンコ",
+	"ナツミ", "コウジ", "マサミ", "ケイ", "ミツテル", "ハジメ", "タクジ", "カツミ", "ノゾミ", "ヤスコ",
+	"カツミ", "カズトモ", "トオル", "ヒロシ", "サトミ", "ユキヒロ", "タカミチ", "ノゾミ", "エイスケ", "ケイキチ",
+	"タケイチ", "ヨシマサ", "ショウヘイ", "ユリ", "サジュウロウ", "ルミコ", "アキヨシ", "カツユキ", "タダシ", "ミヨ",
+	"レイコ", "ナガオ", "ミツホ", "ソウザブロウ", "タカヨシ", "ヒロエ", "ヒロアキ", "ヒトミ", "サトミ", "テルヨシ",
+	"スミ", "マコト", "ヤスシ", "ノブオ", "マコト", "ミハル", "マサハル", "ミサト", "タキコ", "トシロウ",
+	"セイゴ", "シンジ", "リエコ", "ソウノスケ", "キイチロウ", "メグミ", "ヤスヘイ", "ヨシカズ", "テルカズ", "ナオユキ",
+	"ウメタロウ", "ケイ", "タケヨシ", "コウタロウ", "ゼンジ", "トモユキ", "トシノリ", "アキオ", "タカマサ", "トクコ",
+	"ミキ", "ユキ", "タカオ", "ヨシノリ", "ノリアキ", "イクオ", "タカヒコ", "ヒロエ", "マサオミ", "レイナ",
+	"モトミ", "コウジ", "ユリ", "リエ", "ケサオ", "マサヤス", "ミチヨシ", "リエ", "ナツコ", "ショウヘイ",
+	"モトミ", "ミキ", "モトヒサ", "ショウコ", "モヘイ", "サダジ", "トシミ", "ヤスヒロ", "トミオ", "マユミ",
+	"リョウイチ", "モトイ", "サヤカ", "モトミ", "レイ", "ユカ", "シンジロウ", "エイハチロウ", "クラミ", "セツミ",
+	"トモコ", "シズエ", "マツヨ", "エイノスケ", "ヒロエ", "シンジ", "ミサト", "シュンジ", "フミノリ", "クラミ",
+	"タケシ", "アイ", "ハルオ", "サトル", "リカ", "ヨシヤ", "モトノブ", "ミヨ", "ショウジロウ", "キョウコ",
+	"トモ", "トミコ", "マキ", "トシミツ", "シンイチ", "トシカツ", "イクゾウ", "ジンザブロウ", "アキオ", "ユウコ",
+	"マサユキ", "ユカ", "ヒロシ", "ウンキチ", "ミハル", "シュウヘイ", "カヨコ", "ヒロユキ", "ヨシノブ", "トヨアキ",
+	"カズヒサ", "ノブエ", "キシロウ", "トヨアキ", "シゲザブロウ", "シンゴ", "ユウコ", "マリ", "カツヒデ", "クニエ",
+	"ヨシオ", "ヨシヒロ", "マサテル", "ユウイチロウ", "カズヤ", "マキ", "ヨシヒデ", "ツトム", "ヨシオ", "ケイタロウ",
+	"タダアキ", "シゲヤ", "カツジ", "シンイチ", "スミ", "ユウコ", "ケンジ", "トキオ", "トモアキ", "ナオミ",
+	"ゼンジ", "カツジ", "サダユキ", "フミ", "マサチカ", "キンジ", "ヒロハル", "セイシロウ", "ヨシノブ", "リュウキチ",
+	"ミキ", "ミツオ", "リエ", "ヨウコ", "ノブハル", "ケサオ", "カヨコ", "ミチヨシ", "クニミ", "ユウコウ",
+	"ショウゾウ", "マスゾウ", "シンジロウ", "ショウイチ", "マサノリ", "キンジ", "シゲトシ", "ヒデジロウ", "ヨシトシ", "マキ",
+	"テルマサ", "ノリオ", "ヨシナオ", "カズノリ", "ムネシ", "アリカ", "コウジ", "ミキ", "サジュウロウ", "トシカツ",
+	"ハナヨ", "トモ", "チズ", "シゲゾウ", "シズオ", "ヨウコ", "ヒサチカ", "アリカ", "カツヒロ", "ハルミ",
+	"ヤスミツ", "フサミ", "シゲノリ", "タダカズ", "ジュンコ", "ブンゴ", "トシツグ", "タダヒロ", "ツトム", "トミヨシ",
+	"リエコ", "コウジ", "カズノリ", "アカネ", "ヒコヨシ", "マスミ", "コウスケ", "トヨシ", "ナオアキ", "ノゾミ",
+	"スミ", "コウゾウ", "ノリカツ", "シズオ", "ヤスヘイ", "トモヨシ", "ケンゾウ", "ヨシヤ", "リョウコ", "コウザブロウ",
+	"ユリ", "ショウジ", "シュンロウ", "アスカ", "マサヒロ", "ノリシゲ", "サトシ", "タダカズ", "アリカ", "ヨシエ",
+	"ヒロキ", "ヨシコ", "カツヒデ", "エミ", "チエ", "チエ", "ヨシタカ", "フミユキ", "コウジ", "セイジ",
+	"シゲヤ", "マキ", "セツミ", "シゲイチ", "ヨシヒサ", "タカノリ", "ツトム", "カツモト", "リュウキチ", "エイジュ",
+	"マコト", "エツコ", "タダスケ", "アツヤ", "ユキト", "ヒョウキチ", "トモ", "ノリオ", "タカアキ", "テツジ",
+	"ノリシゲ", "マサヒロ", "リエコ", "ヒロキ", "ユウコウ", "アキヒロ", "アヤコ", "ミキ", "ノブヒロ", "ミチマサ",
+	"トモユキ", "イワミ", "ゼンジ", "コウザブロウ", "コウコ", "シュンロウ", "キヨヒロ", "トモミ", "ヒロユキ", "タキコ",
+	"サダジ", "サユミ", "マユミ", "チセコ", "ヨウイチロウ", "ヨウノスケ", "ノリシゲ", "エイノスケ", "ジュンコ", "チズ",
+	"フミユキ", "タカアキ", "キチジ", "アキヨ", "アカネ", "ツネジ", "ジュンコ", "ヨシノブ", "チエコ", "アキミ",
+	"タキコ", "サダヒサ", "ソウノスケ", "タカジ", "タダヒロ", "ツギオ", "ミツホ", "ショウイチ", "レイコ", "タメイチロウ",
+	"トモコ", "サダヒサ", "タケシ", "トモアキ", "イクミ", "アキノリ", "ジュンコ", "ツグオ", "タヅコ", "シン",
+	"ジュンコ", "カツジ", "マサトシ", "エミ", "カズヤ", "タイゾウ", "ユウコ", "マサヒロ", "トシツグ", "チズ",
+	"マサヒロ", "ミツホ", "カズミ", "ヨシフミ", "ヒロヒト", "サチオ", "コウゾウ", "ユウイチロウ", "マサエ", "レナ",
+	"カメオ", "ヨウコ", "ナオタケ", "ハナヨ", "アキヒロ", "ミツオ", "ミノブ", "ウメタロウ", "ヨシミ", "ケイシ",
+	"シュンジ", "ノリユキ", "ヨシマサ", "レナ", "マサチカ", "ケイジ", "ヨシオ", "イワミ", "ハルジ", "シュウジ",
+	"ケンジ", "ヒロシゲ", "ミチヨシ", "ユウカ", "サヤカ", "カズミ", "ユウ", "マスミ", "ショウキ", "ヨシエ",
+	"ヒロカズ", "ユイ", "レイヤ", "ツバサ", "シンタロウ", "ユキオ", "トモエ", "ミツノリ", "マサミ", "ヨシタカ",
+	"ケンジ", "ルリコ", "ナガオ", "カツアキ", "タメイチロウ", "ミヨ", "ヒサヤ", "ナガオ", "シゲヨシ", "リサ",
+	"ユウカ", "キンジ", "ヨウコ", "シゲミ", "タツミ", "サワコ", "リョウジ", "ユウコ", "ヤスタミ", "テルヨシ",
+	"カツユキ", "マコト", "エイハチロウ", "ヒロヤ", "ケイスケ", "ヤスオ", "ショウキ", "タカマサ", "ミキ", "アキノリ",
+	"マサヒロ", "マサエ", "リュウジ", "マキコ", "マキ", "トモエ", "ユウイチロウ", "アサミ", "アキラ", "ヤスミツ",
+	"キクハル", "シゲフミ", "ゼンジ", "カンジ", "ヨシトシ", "タカシ", "コウキ", "シズオ", "リョウイチ", "ナオコ",
+	"トクコ", "ミハル", "トモコ", "エイスケ", "ヨシノリ", "マキ", "トキヨ", "キヨノブ", "ミツエ", "ヒトシ",
+	"ヨウコ", "ユリ", "カズヤ", "タカヤ", "ヒデノリ", "キヨシゲ", "コウスケ", "ハルユキ", "トシヒロ", "リエ",
+	"マキ", "ヒトミ", "イッセイ", "クラミ", "サヨコ", "マツヨ", "カネヨシ", "レイ", "ヒロエ", "マサヒコ",
+	"ジュンコ", "ケイスケ", "ヤスタミ", "シゲユキ", "オリエ", "ノブコ", "ヒロユキ", "ナオコ", "トモミ", "マサミツ",
+	"キヨノブ", "ノリオ", "ノブヤ", "トシヒロ", "クニミ", "マサテル", "タカヤ", "テルヤ", "アキヒサ", "シゲヨシ",
+	"ナミ", "エイキ", "フミオ", "マサミツ", "ケンイチ", "アキミ", "リカ", "アツナリ", "ヒロヒト", "リカ",
+	"シゲゾウ", "エイジュ", "ヤスジ", "ツバサ", "ミキ", "テツジ", "ナリミ", "シュンジ", "シゲノブ", "リエ",
+	"ミツジ", "エリ", "ナオヒロ", "エツコ", "ヒロヒト", "リエ", "トモアキ", "トヨアキ", "アキオ", "カツト",
+	"トシミ", "ユキト", "サチミ", "テルヤ", "アキノリ", "カズシゲ", "カズシゲ", "マキ", "エイノスケ", "リョウジ",
+	"マリ", "シゲイチ", "アイ", "ナオミ", "エイノスケ", "マサジ", "ユウコ", "キイチロウ", "ハルヒト", "ヒロヨシ",
+	"トモエ", "ヤスミ", "リエ", "ミチカズ", "シンヤ", "ユウ", "サダヒサ", "トモミ", "ヨシエ", "ミホコ",
+	"リュウジ", "ヒトシ", "ソウノスケ", "サンペイ", "タツオ", "ヨシハル", "トヨシ", "モモヨ", "トクヒコ", "スミオ",
+	"タエコ", "ヒサノリ", "シン", "ヤスシ", "ヨウノスケ", "ノリオ", "カネノリ", "セイイチ", "トミコ", "ユウカ",
+	"ノリユキ", "シゲミ", "ノゾミ", "ミキ", "トクコ", "セツミ", "リュウゾウ", "ナミコ", "シュンロウ", "タカノリ",
+	"トモナリ", "ユウカ", "タダカズ", "ナオミ", "トオル", "マサヨシ", "ヨシユキ", "シン", "ヤスオ", "タエコ",
+	"トモミ", "エイノスケ", "エリ", "マサル", "シゲミ", "タツタロウ", "ヒロエ", "サトシ", "コウジ", "レイ",
+	"ミチコ", "コウザブロウ", "マキ", "タダシ", "ナオヒロ", "ヨシユキ", "チエコ", "キミオ", "シンヤ", "リエコ",
+	"ケイキチ", "トモミ", "スミノリ", "ツトム", "トシミ", "ハルジ", "ヤスユキ", "ユウコ", "マチコ", "ヨウイチロウ",
+	"タメイチロウ", "ヒロヒト", "タダユキ", "キヨタカ", "ユウイチロウ", "ヒロム", "カズヨシ", "マサノリ", "ヤスヒロ", "ノリオ",
+	"ヒトミ", "リュウキチ", "フユキ", "トモミ", "タカヒコ", "ツグオ", "カズキ", "ミサト", "タミコ", "トクコ",
+	"サダヒサ", "トミコ", "アイ", "テツジ", "ヒロユキ", "ジュンイチロウ", "ケイタロウ", "ハツミ", "ジュンタ", "シュンジ",
+	"トシヒロ", "ノリユキ", "コウキ", "ユウミ", "ジョウスケ", "ヨシヒロ", "タケイチ", "ヤスユキ", "ミツノリ", "リキヤ",
+	"セイシロウ", "リサ", "シンペイ", "リュウキチ", "ヨシトシ", "チヨエ", "ヒロカズ", "リエ", "レイ", "ヨウノスケ",
+	"キチジ", "ウンキチ", "アキ", "マキ", "タツミ", "ケイスケ", "カズト", "タクジ", "ミチマサ", "ヤスゾウ",
+	"エイゾウ", "アツヤ", "ヨシオ", "マサキ", "マサタカ", "イチオ", "アユミ", "ミツノリ", "ヒサノリ", "ハツミ",
+	"テイジ", "ノリアキ", "クニミ", "ユウコ", "ケイジ", "アキラ", "ヨシオ", "トモミ", "ミツノリ", "シゲミ",
+	"シュウジ", "シゲノブ", "マサノリ", "ヨウコ", "アキオ", "ノブヤ", "ミキ", "シゲノリ", "ヒロエ", "カツアキ",
+	"ナオコ", "キヨカズ", "シン", "レイナ", "ケンジ", "ユウコ", "ノリユキ", "サダジ", "シゲキ", "ヨシロウ",
+	"ミノブ", "リエコ", "ハルノ", "エイハチロウ", "トオル", "ノブヨシ", "スミタカ", "タダカズ", "ヤスコ", "ユウコ",
+	"アサミ", "サクコ", "リョウイチ", "ウメタロウ", "ヨウジ", "ノブト", "キヨヒロ", "トオル", "グンイチ", "レイコ",
+	"シンゴ", "ナツミ", "ケサオ", "ヒデミツ", "セツミ", "シゲユキ", "トモ", "ヤスノリ", "ケンゴ", "タクジ",
+	"タダカズ", "ナリミ", "トモエ", "エイスケ", "タカミチ", "シゲフミ", "トモ", "ヒデジロウ", "タイゾウ", "トシヤス",
+	"ヒデノリ", "トシミ", "カンジ", "チコト", "クニエ", "サワコ", "ツトム", "ミキ", "レイ", "ミチ",
+	"マスゾウ", "カズヒロ", "シン", "リュウゾウ", "テルヨシ", "テルヤ", "マキ", "タケヨシ", "ミエコ", "アキミ",
+	"リエコ", "キョウゾウ", "ユキオ", "ハルオ", "タエコ", "モトオミ", "トヨツグ", "エミ", "ヒロム", "アキヨシ",
+	"ヨシヒロ", "ハツミ", "タエコ", "モトイ", "ジュンイチロウ", "タカヒコ", "エツコ", "マサシ", "イサミ", "キヨシゲ",
+	"キンヤ", "アキミ", "マサノリ", "アヤコ", "ヨシノブ", "ツトム", "ヨシハル", "ヨシミ", "タカヨシ", "ミチマサ",
+	"マスゾウ", "ミチマサ", "ヨシヒロ", "アスカ", "ショウヘイ", "ヒサミ", "セイゴ", "カズト", "コウジ", "ノブハル",
+	"ジュンロウ", "マサノリ", "アユミ", "トシミ", "ユキヒロ", "リカ", "トキジ", "マリ", "ミチオ", "ヒロカズ",
+	"ルリコ", "スイセン", "タカオ", "アユミ", "ヒロヤ", "タケイチ", "ヒデオ", "エミ", "ヒデユキ", "ヨシタケ",
+	"ショウゾウ", "ミキ", "リカ", "リョウジ", "ヨウジ", "フミユキ", "ヤスヒデ", "キミエ", "リエコ", "モトイ",
+	"チョウイチロウ", "ヨシエ", "セイゴ", "トシオ", "トシオ", "ケイシ", "マサル", "シゲミ", "サトル", "シゲゾウ",
+	"キョウジ", "カズノリ", "ユキ", "ミホコ", "ユキノブ", "ヤスタミ", "シゲノブ", "コウジ", "ユウキ", "キュウサク",
+	"イサオ", "ヨリフミ", "シンゴ", "レイナ", "ノリオ", "ノゾミ", "ヨシクニ", "シゲヨシ", "マサタカ", "キクコ",
+	"セイイチ", "リサ", "セイジ", "ナリミ", "ノリカツ", "ユウ", "ヤスミツ", "ヨシアキ", "フミ", "ケンゴ",
+	"リエコ", "エイジュ", "トモコ", "ミノブ", "トシロウ", "マサフミ", "アキコ", "カネノリ", "ヨシシゲ", "ナオキ",
+	"トモヨシ", "カンジ", "トモカツ", "ナオコ", "クニミ", "ハナヨ", "コウイチ", "ヒコヨシ", "カズシゲ", "タダヒロ",
+	"ミヤビ", "エリ", "トモコ", "ミチ", "ヨシエ", "アスカ", "アキ", "ツネジ", "シゲヨシ", "ヒロカズ",
+	"ミツエ", "ユイ", "エイノスケ", "テルヨシ", "イッセイ", "シンゴ", "タモツ", "ハツミ", "ヒロユキ", "タキコ",
+	"ミツヨシ", "ノリユキ", "ユカ", "マサヒロ", "チョウイチロウ", "アキヒサ", "ナミコ", "フミオ", "オリエ", "ヨシヒデ",
+	"アツナリ", "マサユキ", "タケヒサ", "タカシゲ", "ユウコ", "マコト", "ヨウコ", "スミ", "ヒロシゲ", "ヒデカズ",
+	"セイゴ", "ヒロユキ", "ショウジロウ", "イサオ", "セイヤ", "モトヒサ", "アイ", "ヤスシ", "カズトモ", "モトヒサ",
+	"キシロウ", "キヨ", "ヨシシゲ", "アイカ", "トモミ", "アキヨシ", "ショウジロウ", "マユミ", "ヒロミ", "ヒロエ",
+	"チズ", "チエ", "ヨシキ", "ユウコ", "リヘイ", "マサテル", "タケヒサ", "レイコ", "ユキオ", "ヤスヒロ",
+	"モトヒサ", "ヨシハル", "ケンジ", "ハナヨ", "マサテル", "ノブト", "センジ", "タカヤ", "ヤスジ", "テイジ",
+	"ヨシオ", "トラノスケ", "マコト", "レイコ", "ユキト", "モトオミ", "トミヨシ", "トモコ", "タダヒロ", "ナオタケ",
+	"マサミツ", "フサミ", "マスミ", "ヒロミ", "シンペイ", "トクヒコ", "ノブヤ", "ナオヒロ", "ヤスジ", "ユウカ",
+	"ミチヒコ", "ヨシノブ", "ヤスシ", "レイナ", "コウコ", "シンジ", "レイイチ", "トモヨシ", "ショウキ", "ノブト",
+	"カツヒロ", "ヒロユキ", "カメオ", "タツミ", "ハルヒト", "アキオ", "ヒビキ", "ナガオ", "シンヤ", "ヨウコ",
+	"ナガコ", "キヨカズ", "ヒデトシ", "クニヨシ", "キンヤ", "ヒロキ", "ムツオ", "クニヨシ", "シンヤ", "ケイジ",
+	"カズヒロ", "ハルノ", "モトイ", "マサタカ", "ノリオ", "キワ", "コウコ", "キヨタカ", "ジュンジ", "ヨウコ",
+	"トモミ", "エイハチロウ", "ショウコ", "ナオアキ", "カズヤ", "タカヒコ", "サクコ", "アツヤ", "トミオ", "モモヨ",
+	"ミツエ", "サダオ", "エイスケ", "ナオアキ", "ヒロマサ", "タダアキ", "ヒデオ", "モトヒサ", "トキジ", "ユウキ",
+	"エイキ", "アヤ", "シズオ", "ミツオ", "タクジ", "キイチロウ", "カズマ", "カズミ", "リエ", "キヨシゲ",
+	"トシカツ", "セイゴ", "サヤカ", "トシカツ", "エリ", "カクタロウ", "ミチカズ", "ジュンコ", "ナオコ", "ヤスヘイ",
+	"ヨシツグ", "アキミ", "トラノスケ", "エイキ", "タカヒロ", "ミキヤ", "ノリオ", "リョウジ", "サダオ", "セイコ",
+	"マサオミ", "スミタカ", "セイコ", "トモユキ", "ノブコ", "エイゴ", "リエコ", "レイナ", "コウジ", "アキラ",
+	"シンゴ", "テルヨシ", "ノブオ", "ヒロシ", "トシオ", "キミキチ", "コウコ", "ユキ", "リョウイチ", "カメオ",
+	"ヨシキ", "スエタカ", "マサアキ", "テルコ", "グンイチ", "サンペイ", "キヨシゲ", "ヨリフミ", "ユウコ", "クニヒロ",
+	"トモ", "ケイスケ", "キクコ", "レイナ", "エリコ", "マサハル", "トラノスケ", "アキオ", "ヒデハル", "ムツオ",
+	"ウキョウ", "ユウシロウ", "ユウカ", "タカミチ", "アツナリ", "マサヨシ", "ミハル", "セイコ", "センジ", "ヒロキ",
+	"ナオアキ", "キュウサク", "シンゴ", "サヤカ", "モリカツ", "コウタロウ", "アキヒサ", "コウジ", "ヒロエ", "テツヤ",
+	"シュウジ", "ミサオ", "サユミ", "ヨシカツ", "フミコ", "キンヤ", "ノブコ", "ケイキチ", "ツグオ", "ケイスケ",
+	"イワミ", "ヨシエ", "ユキムラ", "フミノリ", "リョウコ", "リサ", "リカ", "アキラ", "トモ", "アキヒサ",
+	"マサハル", "チエコ", "ヒサエ", "タイゾウ", "ヤスオ", "ユウコ", "セイナ", "トオル", "コウコ", "タケトシ",
+	"ユリ", "ヤスジ", "チエコ", "ノブエ", "ヒトミ", "リョウコ", "ユキヒロ", "タダユキ", "マサノリ", "リュウキチ",
+	"シゲヤ", "トキオ", "タダスケ", "ミチオ", "ミキ", "ショウゾウ", "ノブオ", "トシノリ", "トモミ", "ジュンイチロウ",
+	"ノリオ", "テルカズ", "シュンジ", "ジュンコ", "タモツ", "ケンタロウ", "ヤスヒロ", "スイセン", "サトシ", "トモエ",
+	"アイ", "マサヒコ", "カオリ", "ヤスミツ", "ヒデオ", "トシアキ", "リカ", "シゲノブ", "ヨシエ", "メグミ",
+	"ヨシマサ", "カネノリ", "トモオ", "カメオ", "ヒサノリ", "テツヤ", "ヨシアキ", "ヒビキ", "ゼンジ", "タカノリ",
+	"ヨウジ", "アキノリ", "ヨシオ", "マサハル", "ナミコ", "リカ", "ソウスケ", "シゲユキ", "ナガオ", "ユウコ",
+	"タヅコ", "マサエ", "シゲフミ", "ヨウスケ", "ヨシヤ", "イッセイ", "ヒデトシ", "マサミ", "ヒサエ", "ミツノリ",
+	"ケンゴ", "トヨツグ", "キョウア", "ヒロハル", "ミサト", "トクヒコ", "タケオ", "リョウヤ", "ミサト", "マコト",
+	"マサトシ", "カツト", "ヤスヒロ", "ナオミ", "ヨウコ", "キヨカズ", "ツネジ", "イクゾウ", "カヨコ", "タケトシ",
+	"シンゴ", "マサジ", "ユキ", "ヨシカズ", "マサジ", "ノリカツ", "ユウコ", "イチオ", "タカシゲ", "ユキムラ",
+	"ノブヨシ", "トモハル", "キイチロウ", "サンペイ", "レイコ", "エイハチロウ", "カズシゲ", "スエタカ", "トキジ", "タツミ",
+	"タダユキ", "ミハル", "トリゾウ", "ナオコ", "ヨシシゲ", "キクエ", "タツミ", "タダアキ", "ナツコ", "クニヒロ",
+	"カズキ", "シンジ", "タカヒデ", "ヨシヒロ", "タダアキ", "ヒロエ", "ショウイチ", "トシオ", "タモツ", "ナオミ",
+	"キクハル", "フミ", "メグミ", "マサキ", "リョウイチ", "ヒサエ", "ソウノスケ", "ヒロユキ", "チセコ", "トモナリ",
+	"マツジロウ", "ミチカズ", "マキコ", "カズヨシ", "カクタロウ", "クニミ", "ヨシトシ", "アキヒロ", "タカヒデ", "ヒロアキ",
+	"ユウキ", "ヤスヒロ", "ヒサヤ", "コウザブロウ", "クニヒロ", "ヒデカズ", "ヨシマサ", "セイシロウ", "リエ", "テルコ",
+	"トモミ", "マリ", "ノリオ", "アキミ", "フミオ", "コウジ", "タカシゲ", "ミツエ", "シンタロウ", "エリコ",
+	"ナオヒロ", "エリ", "ユウコ", "トモミ", "ミチヒコ", "マスゾウ", "テイジ", "ミツノリ", "ミホコ", "ノゾミ",
+	"ノリユキ", "タキコ", "ヨシヒデ", "ヨシオ", "タイゾウ", "カツユキ", "ヨシキ", "モトノブ", "カズヨシ", "リエコ",
+	"トシミツ", "カヨコ", "サトシ", "ヨウスケ", "ヒトシ", "トシヒト", "エイノスケ", "ミハル", "ノゾミ", "カズヒロ",
+	"キミノリ", "ナツコ", "リサ", "カズヤ", "リカ", "エイゴ", "ショウヘイ", "タカトシ", "アキヒロ", "タダスケ",
+	"ナオユキ", "ヒロヤス", "ヨシカツ", "ミツエ", "マサヒロ", "ミチヨシ", "セイジ", "コウゾウ", "ヘイサク", "リカ",
+	"シンペイ", "ナリオ", "キワ", "トシエ", "トモミ", "ヨシタケ", "コウジ", "キヨノブ", "ヒコヨシ", "キワ",
+	"タカヤ", "タダスケ", "キクエ", "マチコ", "ヒデハル", "トモナリ", "マサミツ", "ノリオ", "シュンロウ", "ナリオ",
+	"ミオ", "キヨヒロ", "キミエ", "レナ", "ヒロノリ", "マツヨ", "タカシ", "ミチマサ", "トシロウ", "リエコ",
+	"ハジメ", "エリコ", "リサ", "マサヤス", "コウスケ", "フミコ", "ヨシヒロ", "ケイ", "ヒデミツ", "ジュンロウ",
+	"タミコ", "ノリオ", "カンイチ", "トモコ", "シュウジ", "タメイチロウ", "オリエ", "カツモト", "エイノスケ", "トシヒト",
+	"ミキ", "ノブエ", "ヨシツグ", "リカ", "カツヒロ", "ヨウノスケ", "マモル", "トリゾウ", "アヤコ", "トモカツ",
+	"モリカツ", "ヤスノリ", "レイナ", "ヒトミ", "ヒサヤ", "ミキヤ", "ヨシハル", "オリエ", "ユミ", "トヨアキ",
+	"マサトシ", "シゲヨシ", "ナオアキ", "タカシ", "タツタロウ", "リサ", "タカシ", "ジュンコ", "イッセイ", "シンタロウ",
+	"タカシ", "ノブコ", "ナオユキ", "ヒサエ", "トシエ", "タダヒロ", "ヒロマサ", "タヅコ", "シゲツグ", "リョウジ",
+	"エイキ", "サトシ", "アツナリ", "ショウヘイ", "ヒロマサ", "ユウシロウ", "カネノリ", "アツシ", "フミ", "ケイ",
+	"マサオ", "アユミ", "ルリコ", "ノブヤ", "マサヒロ", "カズヤ", "マサエ", "トシヤス", "キワ", "ナオミ",
+	"トモハル", "シンイチ", "ヒデシ", "スミ", "カツミ", "シゲノブ", "マサミ", "トモカツ", "タダアキ", "エミ",
+	"エリ", "ケンジ", "シゲゾウ", "トキオ", "ハルジ", "マチコ", "シゲザブロウ", "タイゾウ", "ケイシ", "タモツ",
+	"キュウサク", "ヒロユキ", "デンザブロウ", "コウシロウ", "トシコ", "イクオ", "ジュンロウ", "マサノリ", "センジ", "ミヤビ",
+	"テルヨシ", "ユウシロウ", "トラノスケ", "ミツジ", "ヨシハル", "トラノスケ", "サクコ", "ナミコ", "クニヨシ", "コウザブロウ",
+	"カネヨシ", "アキオ", "ヨウコ", "レイナ", "タカアキ", "ミサオ", "レイナ", "ツギオ", "マサヒロ", "ナリオ",
+	"レイナ", "イクオ", "ユウコ", "ノゾミ", "マサフミ", "ミチヨシ", "マキ", "マサオ", "タダアキ", "トシアキ",
+	"ミハル", "ショウゾウ", "ソウザブロウ", "ケイジ", "ヨウジ", "ナオミ", "トクヒコ", "タツヤ", "メグミ", "タエコ",
+	"トヨシ", "ハルノ", "キョウコ", "テツジ", "サダユキ", "エイキ", "ヒビキ", "ユキヒロ", "コウスケ", "ツネユキ",
+	"タケシ", "マリ", "ミノブ", "テツヤ", "トヨシ", "アキコ", "ハルミ", "ミサオ", "ユウゾウ", "タカヒデ",
+	"ヒトミ", "ノリオ", "キチジ", "ヨシナオ", "ナミ", "キヨヒロ", "ケサオ", "ミサト", "ヨシタケ", "エツコ",
+	"ミキ", "トモコ", "ミチヨシ", "ミキオ", "タケヨシ", "サトミ", "サヨコ", "マサオ", "クニヒロ", "エイゾウ",
+	"ノリユキ", "マスミ", "コウジ", "ムネシ", "ナオコ", "スエタカ", "ミツエ", "ヒロカズ", "エイゴ", "サチミ",
+	"ノリオ", "リョウイチ", "ミツノリ", "レイコ", "ヨシユキ", "タクジ", "ジュンロウ", "リエ", "アキヒロ", "シゲフミ",
+	"カツシ", "リョウジ", "ヨウノスケ", "ケンタロウ", "ヨリフミ", "キクオ", "ユミ", "ユキト", "ヒロシ", "ヨシヒロ",
+	"ジュンイチロウ", "マツヨ", "サヨコ", "サダオ", "ミツテル", "モトオミ", "カズキ", "ヨシコ", "ナミコ", "タカヒデ",
+	"カズトモ", "イクゾウ", "ヒトキ", "キミキチ", "サヤカ", "ヒトキ", "キヨヒロ", "シンタロウ", "ヒョウキチ", "マキ",
+	"ミエコ", "サダヒサ", "ナオコ", "トモ", "サダユキ", "ミチヒコ", "アイ", "シンヤ", "フサミ", "ヨシヒロ",
+	"ハナヨ", "ヨシクニ", "ユウコ", "マサヒロ", "タモツ", "モトミ", "ヨシツグ", "ケンタロウ", "シゲミ", "シンヤ",
+	"レナ", "ヨシヒロ", "トシノリ", "タミコ", "トモカツ", "タヅコ", "ユキコ", "ツネユキ", "ミキ", "キョウア",
+	"イッセイ", "アキノリ", "スエタカ", "リュウジ", "ノリオ", "ユキト", "エイスケ", "タカシ", "タカヒロ", "キミキチ",
+	"テルカズ", "クラミ", "ミホコ", "ヨシノブ", "ジョウスケ", "テツジ", "シゲヤ", "ヨシエ", "タエコ", "タケヨシ",
+	"ユキノブ", "マリコ", "ヒサチカ", "カツシ", "ムツオ", "トモヨシ", "タダシ", "ルリコ", "トシヒコ", "タカシゲ",
+	"トヨシ", "ユウカ", "ナオヒロ", "ツネヒロ", "アキヒロ", "マサユキ", "ヤスミツ", "ノリシゲ", "トシヒコ", "アキオ",
+	"ノゾミ", "トシジ", "ハルミ", "ヨシマサ", "タエコ", "アキヒロ", "シゲミ", "トシヒト", "リュウジ", "ヒロユキ",
+	"トシツグ", "カズノリ", "ムツオ", "ヤスタミ", "フミユキ", "ヒロエ", "タクジ", "コウジ", "ユウ", "テルコ",
+	"ウキョウ", "チズ", "ユウコウ", "ツネユキ", "ユキコ", "エイゾウ", "アヤコ", "カンイチ", "ユウシロウ", "エイジュ",
+	"ヨシユキ", "タエコ", "マコト", "イッセイ", "トモコ", "ヒトミ", "ツバサ", "マモル", "キョウア", "シゲイチ",
+	"アキコ", "ヨシタカ", "カオリ", "トシノリ", "ノリシゲ", "タツヤ", "リョウジ", "ユミ", "ヒデシ", "ショウキ",
+	"トモコ", "ゲンペイ", "ヤスタミ", "ヒロシゲ", "ナガコ", "リエ", "サトミ", "ヨシヒデ", "タケヒサ", "タカヒコ",
+	"ムツオ", "リエコ", "アキコ", "ヨシカズ", "ヒロオ", "トモカツ", "モヘイ", "マサオ", "イチオ", "コウジ",
+	"トモタカ", "タダアキ", "スミノリ", "ヨシフミ", "ミサ", "ヨシアキ", "アイ", "タケオ", "リエ", "タダカズ",
+	"シンキ", "エミ", "トモコ", "フサミ", "ヒロヨシ", "トミオ", "キシロウ", "ソウザブロウ", "キシロウ", "キヨノブ",
+	"リサ", "キヨシゲ", "カツヒロ", "ヨシヒサ", "マキ", "シゲヨシ", "キヨタカ", "ミキ", "トモコ", "ヨシカツ",
+	"チエコ", "トモナリ", "マサヨシ", "コウジ", "ヤスミツ", "ハンスケ", "ミエコ", "フミコ", "ユウカ", "トミコ",
+	"ミノブ", "マサノブ", "ヒデノリ", "マツヨ", "キクコ", "ハルヒト", "トミオ", "シゲザブロウ", "マサミツ", "キンジ",
+	"ヒトキ", "レイヤ", "ナオミ", "サクコ", "ユキムラ", "テツアキ", "ノブコ", "ナオアキ", "トモ", "シュウヘイ",
+	"ヨシタケ", "ミチヒコ", "ヨシツグ", "ミキ", "ケイタロウ", "テイジ", "マサエ", "タカヨシ", "トモコ", "カツシ",
+	"ツネジ", "キョウア", "マサハル", "ナオヒロ", "ケンゾウ", "ヨウイチロウ", "レイナ", "ノゾミ", "ケンイチ", "エイゴ",
+	"ヒデユキ", "マサヒコ", "リエコ", "ヤスシ", "ミチマサ", "アヤ", "ツネカズ", "アユミ", "ミツノリ", "アリカ",
+	"ヒサヤ", "アキオ", "ヨシノリ", "ナミ", "カクタロウ", "モリカツ", "エミ", "サヤカ", "ナオキ", "ヨウノスケ",
+	"タダアキ", "キヨタカ", "ヒロユキ", "リョウヤ", "ユウコ", "リエ", "ヨウコ", "セイイチ", "エイキ", "タツヤ",
+	"カオリ", "トモ", "カンイチ", "ヨシヒロ", "グンイチ", "リュウゾウ", "シンジ", "マツヨ", "タエコ", "チヨエ",
+	"ナオミ", "ナオユキ", "ノゾミ", "コウイチロウ", "サユミ", "ヨシタケ", "カズヒロ", "タケヒサ", "アツコ", "リュウゾウ",
+	"クラミ", "ヒデノリ", "ショウゾウ", "アキヨ", "ミキ", "タケシ", "カズマ", "トリゾウ", "ユウコウ", "マユミ",
+	"ナツコ", "ヨシツグ", "ヨシアキ", "シナ", "ユウコ", "ノリカツ", "ヨシキ", "トモミ", "マツジロウ", "ユウゾウ",
+	"ハナヨ", "リエ", "キミオ", "ケイキチ", "ヨシハル", "レイナ", "ヨシツグ", "ユウジロウ", "マユミ", "ヨシユキ",
+	"ソウザブロウ", "ユイ", "ナリミ", "ナオユキ", "ヒロヒト", "アキヨ", "キイチロウ", "ヒデミツ", "ヒサエ", "ケンゴ",
+	"タツタロウ", "ナオ", "ハジメ", "ソウザブロウ", "スエオ", "セイジロウ", "ヨシヒロ", "ムツオ", "ケンジ", "ヨウジ",
+	"ユリコ", "ミチヨシ", "リエ", "マサチカ", "エミ", "サチオ", "マコト", "テツヤ", "アツナリ", "タカシゲ",
+	"フミオ", "ハルミ", "アキヨシ", "ユウゾウ", "レイコ", "タカシゲ", "ミチオ", "マサシ", "ナガコ", "ケンジ",
+	"コウスケ", "ノゾミ", "トシロウ", "レイナ", "トシヤス", "ナオコ", "ナオミ", "ウキョウ", "ミヤビ", "ユウコウ",
+	"ヒデノリ", "ケイ", "エリコ", "シゲユキ", "マサシ", "セイヤ", "キイチロウ", "ヒロユキ", "シンタロウ", "カツシ",
+	"トシオ", "モトノブ", "イエツグ", "サダヒサ", "ヤスジ", "ヒデカズ", "ケイジ", "ヒデユキ", "クニオ", "リュウキチ",
+	"タツヤ", "ユリコ", "スミ", "ミチタカ", "ヨシオ", "アツコ", "トモ", "イサミ", "ミチマサ", "マサユキ",
+	"セイナ", "ミヤビ", "リュウジ", "ヤスヒコ", "ヨウコ", "コウジ", "タカヨシ", "ジュンジ", "タカオ", "ナオ",
+	"ヨウノスケ", "マスゾウ", "カズノリ", "ヨウコ", "キヨミ", "シンヤ", "ハンスケ", "カズヨシ", "ヨシオ", "ヨウイチロウ",
+	"ウキョウ", "キチジ", "シンジ", "ユウコウ", "ヨシシゲ", "ヨシエ", "ヤスヒロ", "レイ", "ノブヤ", "ムツオ",
+	"ユウシロウ", "マサジ", "トモ", "スミオ", "ヒロカズ", "ケイタロウ", "エミ", "マキコ", "エミ", "フサオ",
+	"エイノスケ", "タカマサ", "ショウヘイ", "モトヒサ", "マキ", "マリ", "リキヤ", "ヨシマサ", "シュウジ", "アツヤ",
+	"カクタロウ", "ヨシオ", "シンヤ", "ヒトミ", "ミサト", "ヤスシ", "トシミツ", "ウキョウ", "タカシ", "ハナヨ",
+	"タダカズ", "シゲオ", "ケンゾウ", "センジ", "ケンイチ", "モヘイ", "コウゾウ", "シゲヤ", "ヨウコ", "キミノリ",
+	"ヨシシゲ", "キンジ", "モヘイ", "グンイチ", "マキコ", "タダスケ", "ヨリフミ", "スエオ", "ヒサノリ", "ヨウノスケ",
+	"ナオコ", "ウンキチ", "チヨエ", "テツアキ", "ヤスユキ", "カズヨ", "アキ", "トキジ", "サダジ", "ケンゾウ",
+	"ミツノリ", "シゲイチ", "キチジ", "トモミ", "ヨシツグ", "レイ", "エイキ", "イクゾウ", "タカトシ", "カズノリ",
+	"ヨシヤ", "ミノブ", "ノブハル", "マユミ", "アキノリ", "トリゾウ", "ヒロエ", "キンジ", "リエコ", "ジュンコ",
+	"ヨシシゲ", "ヨシユキ", "ケイシ", "ヤスヒコ", "チエコ", "ヨシロウ", "ヤスオ", "セイヤ", "キュウサク", "セイイチ",
+	"ハンスケ", "マサノブ", "ユウカ", "リュウジ", "トシアキ", "トキジ", "ユウ", "ヒデハル", "モトノブ", "コウタロウ",
+	"ミチマサ", "モトミ", "マサミ", "カツト", "ヤスミツ", "トシオ", "マサハル", "ヨシヤ", "ノブヤ", "セイコ",
+	"カオリ", "チズ", "ミキ", "ヨシトシ", "マサノリ", "キミエ", "ヤスゾウ", "カンイチ", "ユウジロウ", "ケンイチ",
+	"チエ", "ヨシミ", "タカジ", "カズシゲ", "ツトム", "シュウヘイ", "エイキ", "キミキチ", "アヤコ", "マコト",
+	"アスカ", "テルコ", "マサチカ", "アキラ", "ケイジ", "ヒデカズ", "ユキムラ", "サトミ", "マキ", "マキコ",
+	"アイ", "ヒデカズ", "ノブヤ", "キヨタカ", "コウジ", "エイハチロウ", "トモコ", "ヨシオ", "マサキ", "マサシ",
+	"ミチヨシ", "アヤ", "スミ", "ヒサノリ", "フミノリ", "カズキ", "ミサト", "ヨシミ", "リョウコ", "セイヤ",
+	"カクタロウ", "コウキ", "カツミ", "ミチタカ", "タダカズ", "ケンイチ", "ユリコ", "ナミコ", "マキ", "エイジュ",
+	"シュウヘイ", "クニエ", "ナオコ", "タケオ", "タダアキ", "マキ", "グンイチ", "ヨシミ", "ヤスノブ", "ヨシヒサ",
+	"ヒデヒロ", "ヤスユキ", "ナリオ", "ヨシユキ", "ヒデカズ", "エリ", "ヨシヒデ", "シンキ", "タカオ", "エイキ",
+	"コウコ", "タカミチ", "タダヒロ", "ヒサシ", "タカオ", "ツネヒロ", "ケイジ", "ケンヤ", "ユキヒコ", "ヨシハル",
+	"ノリユキ", "カクタロウ", "サワコ", "リエ", "ヒデユキ", "トシコ", "エリ", "ヒデヒロ", "セイコ", "デンザブロウ",
+	"モトイ", "マキ", "ノリオ", "ユイ", "ケイキチ", "セツミ", "イクゾウ", "シュウジ", "ヒデヒロ", "ユイ",
+	"ブンゴ", "トモエ", "イサミ", "セイジ", "タカミチ", "タダスケ", "ナリオ", "カツモト", "タヅコ", "シンジ",
+	"アツヤ", "キワ", "アユミ", "レイナ", "サトミ", "レイ", "ケイタロウ", "トモ", "リサ", "ヒサエ",
+	"トモヨシ", "サダユキ", "イクゾウ", "モトミ", "グンイチ", "ヒトキ", "ユウコ", "トシヒロ", "マキ", "トリゾウ",
+	"マサユキ", "マサミ", "タケオ", "マサハル", "ツトム", "ノリヒコ", "フサオ", "ユキムラ", "イソエ", "ヨシミ",
+	"タカオ", "マサヒロ", "タカヨシ", "サトシ", "ノブハル", "タモツ", "ヨシエ", "チセコ", "サンペイ", "シゲノリ",
+	"リエ", "セイコ", "ヤスノリ", "ヒョウキチ", "リュウジ", "トリゾウ", "エイハチロウ", "アヤ", "ヨシロウ", "カズノリ",
+	"ユウミ", "ミツオ", "ジンザブロウ", "リカ", "トモヨシ", "トモミ", "タカコ", "シュウキチ", "ヤスヒコ", "マキ",
+	"ユキロウ", "ユウコウ", "マサチカ", "タエコ", "ヨシオ", "シュウヘイ", "モモヨ", "ヒロキ", "リエコ", "ヒロム",
+	"マサノリ", "ヒロヤス", "リエ", "ミツヨシ", "ヨシツグ", "ヒサシ", "ハルユキ", "ヒロアキ", "エイハチロウ", "ミサト",
+	"カツモト", "ヨシヒロ", "エイゴ", "ジュンジ", "トモユキ", "ヒビキ", "チセコ", "コウジ", "キクハル", "タツタロウ",
+	"ユキムラ", "カズヒロ", "ジュンコ", "リエ", "ユキノブ", "ミツエ", "タカマサ", "ミチオ", "マサオ", "タツミ",
+	"シュウヘイ", "トシミツ", "カツモト", "ユウコ", "ブンゴ", "チコト", "クニオ", "ノリカツ", "ショウゾウ", "ヨウスケ",
+	"ヒロハル", "シゲツグ", "ミチオ", "テツジ", "ウンキチ", "タカノリ", "ケイ", "エミ", "トキヨ", "ジンザブロウ",
+	"ヨシエ", "シンヤ", "イクミ", "トキジ", "トキヨ", "アキヒサ", "ヒロノリ", "ミキ", "ミツエ", "タツオ",
+	"ヨシヤ", "トオル", "ヨウコ", "ヨシフミ", "モトヒサ", "コウイチ", "ナオコ", "ナオユキ", "シゲノブ", "ノリアキ",
+	"トシヒト", "ヨシツグ", "カンジ", "フミ", "キシロウ", "コウイチロウ", "セイゴ", "イチオ", "ナミコ", "ヤスヒデ",
+	"タキコ", "ヒロミ", "シン", "ミサオ", "フミノリ", "リエコ", "ゲンペイ", "ケンジ", "タカヒロ", "コウイチ",
+	"ヤスオ", "ヨシオ", "ノリアキ", "フサオ", "ハルジ", "マキ", "カツヒロ", "スミオ", "マキコ", "ヒロユキ",
+	"ヤスタミ", "ユウシロウ", "ミキ", "レイナ", "ルミコ", "マキ", "シゲノブ", "ミエコ", "ヒサエ", "ミオ",
+	"ノブオ", "シゲキ", "クラミ", "リキヤ", "デンザブロウ", "コウジ", "マサヒロ", "キミオ", "シュウジ", "ミキ",
+	"ヒロオ", "ヒロエ", "マサノリ", "ユウコウ", "トモミ", "ヒロミ", "キクオ", "ミキ", "シュウキチ", "イサミ",
+	"ケンジ", "シゲオ", "アキオ", "トシヤス", "マモル", "シュウジ", "シゲユキ", "トクコ", "トモミ", "ヨシヒロ",
+	"ケイジ", "ヨシユキ", "キヨ", "カズヒロ", "ハジメ", "マサオミ", "ショウジ", "キクコ", "シンゴ", "ヒデノリ",
+	"コウジ", "トシヒト", "タカジ", "ミチタカ", "ユウジ", "トヨシ", "キンヤ", "テルヤ", "ヨシアキ", "エミ",
+	"トキジ", "チコト", "シゲツグ", "カンジ", "アヤコ", "シゲイチ", "コウジ", "クニヨシ", "ギンノスケ", "カツヒデ",
+	"セイヤ", "モトノブ", "タケシ", "アヤ", "トシエ", "カズヒサ", "ツネユキ", "ショウイチ", "トシアキ", "ヨシヒロ",
+	"トシエ", "ヒデシ", "ユウカ", "ミキ", "アキオ", "ノリカツ", "シュンロウ", "ユウコ", "ヤスオ", "ノリオ",
+	"ユウコ", "ノブエ", "エイノスケ", "タダカズ", "コウキ", "シゲヨシ", "トモミ", "ヨシノブ", "ユキ", "ヒロハル",
+	"ナリオ", "ナツコ", "シンヤ", "キヨミ", "アイ", "レイナ", "テルヨシ", "カズト", "シゲヤ", "ジョウスケ",
+	"ショウコ", "ヒロト", "キミオ", "ヒロト", "トクヒコ", "ヨウイチロウ", "トシヒロ", "サトシ", "ヨシキ", "セイナ",
+	"ミチヨシ", "マキ", "シゲフミ", "マサヨシ", "ヨウコ", "サダヒサ", "ノリオ", "シゲヨシ", "ミキ", "メグミ",
+	"クニヨシ", "ヨシヤ", "カズシゲ", "マサユキ", "トモミ", "フミコ", "ミチカズ", "トモミ", "カズヨ", "ノブト",
+	"ヨシノブ", "フユキ", "トリゾウ", "テツヤ", "ヤスシ", "トシヒト", "ノリオ", "シュウヘイ", "タヅコ", "リカ",
+	"サトル", "シンジ", "ハルノ", "アキヒサ", "ユキ", "レナ", "ミキ", "トシツグ", "テルコ", "ヒトキ",
+	"ユウコウ", "ショウゾウ", "ナオコ", "ソウノスケ", "リサ", "ケンゴ", "リュウゾウ", "サワコ", "キョウゾウ", "タカジ",
+	"キヨシゲ", "サダユキ", "マサヒコ", "トモエ", "トモエ", "ミチヒコ", "ユウミ", "ケイキチ", "ハンスケ", "ショウコ",
+	"トキオ", "マサヤス", "ヤスヘイ", "カヨコ", "ナオアキ", "トリゾウ", "シゲノブ", "ジンザブロウ", "ヨシアキ", "トモアキ",
+	"トモナリ", "ジュンタ", "ヨウジ", "リエ", "アヤ", "アキヒロ", "ヒロハル", "モリカツ", "ミツノリ", "マキ",
+	"ヤスミツ", "ミキ", "トラノスケ", "コウコ", "マサノリ", "ケイジ", "ナオミ", "アツヤ", "ヨシロウ", "シズエ",
+	"トシロウ", "ヤスノリ", "ヒデシ", "マサル", "ユキト", "タカヤ", "ノブハル", "ヨシロウ", "モリカツ", "アツシ",
+	"アキヒロ", "ナツコ", "アキコ", "アスカ", "シゲゾウ", "ノリオ", "ユキヒコ", "ナオヒロ", "キミノリ", "マサジ",
+	"トリゾウ", "トキジ", "アツヤ", "ヨウスケ", "キクオ", "ウンキチ", "ヨウコ", "グンイチ", "ユウコ", "ヤスオ",
+	"トモコ", "ヒサエ", "ショウジロウ", "リョウヤ", "エツコ", "テルコ", "ユウイチロウ", "ヨシヒコ", "トモエ", "マキ",
+	"ゲンペイ", "キイチロウ", "ヨウスケ", "カネノリ", "ヒビキ", "サヤカ", "サンペイ", "カツアキ", "ユキオ", "ヤスゾウ",
+	"トクヒコ", "コウジ", "ユイ", "ヒロカズ", "アイ", "ナオアキ", "テツコ", "ヤスジ", "ヨシノリ", "タケシ",
+	"ヨウジ", "ケイキチ", "カネヨシ", "ナミコ", "ミキオ", "チセコ", "マサノリ", "ヒデヒロ", "キンヤ", "ノリヒロ",
+	"ユキロウ", "タツオ", "ナオコ", "カツヒデ", "サヤカ", "ヨシヒロ", "カズト", "トモユキ", "キイチロウ", "ケイ",
+	"タキコ", "ユミ", "ナオミ", "シンジ", "ヤスジ", "ヒサノリ", "ミサト", "タケシ", "ツギオ", "クラミ",
+	"トキヨ", "ツネジ", "アキミ", "ケンヤ", "フサオ", "サヨコ", "マキ", "テルカズ", "タダカズ", "ヨシハル",
+	"トキオ", "ヨシタケ", "カツヒロ", "ノリシゲ", "ユキノブ", "ヨシユキ", "トシアキ", "タカシ", "マリ", "スミ",
+	"ユウコ", "トシコ", "ヒロム", "キミオ", "グンイチ", "ユウシロウ", "ヨシチカ", "マサタカ", "スエオ", "エイゴ",
+	"ツネヒロ", "ナオコ", "ヨシノリ", "ヤスミツ", "キミエ", "ノゾミ", "ノリオ", "ヨシハル", "ヨリフミ", "マサシ",
+	"シゲユキ", "リエコ", "ケンジ", "トクコ", "タケヒサ", "トモヨシ", "マサル", "キクオ", "リカ", "トモミ",
+	"シンジロウ", "シゲゾウ", "ヒデトシ", "アカネ", "スイセン", "トモタカ", "タケシ", "タカミチ", "マモル", "シュウヘイ",
+	"ミハル", "ヨシノブ", "マサヨシ", "ハルオ", "ヨシロウ", "リカ", "ヨシハル", "ヒデユキ", "エリ", "リュウキチ",
+	"トモコ", "アイ", "ユキロウ", "ソウスケ", "リュウジ", "ミサト", "ヨシタカ", "ショウイチ", "ヒデジロウ", "トモミ",
+	"ジュンタ", "アキオ", "クニオ", "エミ", "トシエ", "フミユキ", "タエコ", "ヒサノリ", "キンジ", "ミサ",
+	"サヤカ", "サクコ", "ノブコ", "ユキヒコ", "ヒロヤス", "タツミ", "トシノリ", "ナリミ", "ヨシノブ", "タヅコ",
+	"ユウゾウ", "サトル", "トモミ", "アイ", "ノリヒコ", "ヒビキ", "タカシ", "マサヒロ", "キワ", "ミツホ",
+	"ヒロヤス", "リエ", "リュウジ", "タクジ", "コウコ", "マサオミ", "トモ", "リエコ", "ユウミ", "ヤスオ",
+	"リエ", "ノブオ", "ユキコ", "サヤカ", "ナガオ", "マサノブ", "レイナ", "ヤスシ", "キヨタカ", "ヒデヒロ",
+	"ヨシハル", "ミツホ", "ショウヘイ", "アスカ", "ナオキ", "カズヒロ", "タカヒデ", "マサジ", "ヤスノブ", "マコト",
+	"ヒサシ", "ヨウコ", "ノブト", "ミツヨシ", "マキ", "ジュンコ", "ナオミ", "ケイジ", "タカヨシ", "シゲツグ",
+	"クニヒロ", "アキコ", "サダオ", "ユキノブ", "マサオ", "タイゾウ", "タケイチ", "チセコ", "ハルヒト", "ヒデハル",
+	"サチオ", "トモエ", "エリ", "アツナリ", "マサアキ", "タケヨシ", "ヤスヒデ", "カオリ", "ヨシタケ", "キンヤ",
+	"ヒサシ", "トキジ", "トモナリ", "サジュウロウ", "ヨウコ", "ユキオ", "マサシ", "リエ", "ソウイチ", "カツヒデ",
+	"トモミ", "ミツノリ", "ヨシミ", "ノリオ", "ヨシミ", "リエ", "ヒロエ", "ユウイチロウ", "キヨノブ", "タケイチ",
+	"ケイジ", "ミツエ", "マキ", "トシエ", "タカシゲ", "ミキ", "ソウイチ", "ゲンペイ", "カツアキ", "シュンジ",
+	"マサユキ", "ユキト", "メグミ", "ケンジ", "ケイシ", "テルコ", "トオル", "キョウコ", "ヤスオ", "イエツグ",
+	"キュウサク", "ヨウイチロウ", "ナオユキ", "シンタロウ", "ヨシミ", "トシジ", "ヒトミ", "タメイチロウ", "ツネヒロ", "キチジ",
+	"センジ", "コウイチ", "ミキ", "ノリヒロ", "トヨツグ", "アイカ", "タモツ", "チョウイチロウ", "モヘイ", "シゲイチ",
+	"イサミ", "ケンイチ", "ノリシゲ", "ヒロノリ", "ヨシヒサ", "シュウキチ", "テツアキ", "アイサク", "イワミ", "タケシ",
+	"ユウキ", "ノリシゲ", "シンイチ", "スイセン", "トモミ", "カズヨ", "ミノブ", "スエタカ", "タケヒサ", "エリ",
+	"タキコ", "トシミ", "ハルユキ", "セイゴ", "ミツエ", "ソウスケ", "シン", "ミヤビ", "キミノリ", "アキラ",
+	"サンペイ", "アスカ", "シゲフミ", "トモナリ", "シゲヨシ", "モトミ", "セイジ", "ソウザブロウ", "タカノリ", "センジ",
+	"ユウジ", "エリ", "ナオヒロ", "アキミ", "キヨミ", "ヒトシ", "タメイチロウ", "シゲイチ", "シゲオ", "メグミ",
+	"カズキ", "タカヒロ", "ジュンタ", "ユキムラ", "ナオヒロ", "フユキ", "コウスケ", "シナ", "モトイ", "キョウア",
+	"ケイジ", "レイコ", "マサオ", "カネヨシ", "タヅコ", "タダユキ", "ムツオ", "セイコ", "ヨリフミ", "マサチカ",
+	"ヒロシ", "トヨシ", "カズトモ", "トシヤス", "ヨウコ", "アイ", "ヨシシゲ", "ショウジ", "ショウコ", "ヤスヒコ",
+	"チコト", "トモエ", "ジュンコ", "ヨシキ", "シゲツグ", "ノゾミ", "トラノスケ", "エリコ", "タカミチ", "ヨシツグ",
+	"サチミ", "コウコ", "タカヒコ", "ナオタケ", "ユキオ", "コウジ", "マサミツ", "トモ", "シズオ", "フサオ",
+	"ノゾミ", "ハルヒト", "コウイチ", "マサシ", "ブンゴ", "ヒロヒト", "キクハル", "ヤスユキ", "タカトシ", "ヨウコ",
+	"ソウノスケ", "トヨツグ", "カツヒデ", "ノブオ", "トシミツ", "ミキ", "タクオ", "ヒロム", "ノリカツ", "ナツミ",
+	"キュウサク", "マサオ", "イクオ", "ウキョウ", "ミサオ", "シゲトシ", "トモ", "セイコ", "トシアキ", "シゲノブ",
+	"セイジロウ", "マキコ", "ケイキチ", "ヨシノブ", "トシアキ", "ユウジロウ", "ヒデユキ", "テルヤ", "マユミ", "ハルヒト",
+	"イチオ", "ジンザブロウ", "ヨシハル", "テツヤ", "ミツホ", "ヨシロウ", "カズトモ", "ケイ", "シゲイチ", "ヒサシ",
+	"ハルミ", "シゲノブ", "ミチコ", "エイノスケ", "ヨウコ", "ヤスジ", "カツシ", "エイキ", "ミキオ", "ヒロエ",
+	"アカネ", "ヨシオ", "マキ", "ユキコ", "マキ", "レイナ", "マサヤス", "キヨノブ", "リカ", "トラノスケ",
+	"マサオミ", "ヒロユキ", "ミツノリ", "ウンキチ", "マサヨシ", "ジンザブロウ", "シナ", "ハルユキ", "ショウジ", "ナオヒロ",
+	"エイキ", "キョウア", "ヤスノブ", "カツノブ", "トシジ", "シンタロウ", "トモ", "カクタロウ", "タキコ", "カズシゲ",
+	"ヒトシ", "モリカツ", "ヒロキ", "トヨツグ", "マサミツ", "モトオミ", "ノブヨシ", "アツコ", "タダスケ", "ヒデカズ",
+	"ノブヨシ", "シンタロウ", "マサジ", "センジ", "タカジ", "ケンジ", "ミツオ", "ヒデシ", "ノブコ", "ミキ",
+	"サトル", "テツコ", "ワキコ", "ケンジ", "キンヤ", "ヒトシ", "レイ", "カツユキ", "ミツジ", "ヨシノブ",
+	"チヨエ", "リエ", "ルミコ", "マサル", "リエ", "トモ", "トモエ", "モトイ", "カツジ", "アキオ",
+	"ヒトミ", "キョウジ", "ヤスゾウ", "シゲノリ", "ナミコ", "ミチオ", "フサミ", "タカヒデ", "ナオタケ", "ケイスケ",
+	"レイ", "マユミ", "リョウコ", "チエ", "ナガコ", "ショウゾウ", "トオル", "エリ", "タメイチロウ", "トシヒコ",
+	"ミヨ", "チセコ", "ゲンペイ", "アツコ", "カズヒサ", "テツジ", "サユミ", "テイジ", "シゲフミ", "ヒロヤス",
+	"チヨエ", "タツオ", "ヨシノブ", "モトオミ", "カメオ", "ショウジロウ", "ヤスオ", "カズヨ", "シゲトシ", "ジュンロウ",
+	"アイ", "シュウヘイ", "ジュンタ", "ナガコ", "ショウジロウ", "ノリオ", "エイスケ", "ノリユキ", "カネノリ", "ムツオ",
+	"ルリコ", "トモハル", "ヨシフミ", "タミコ", "ユイ", "ミキ", "サクコ", "ミツテル", "ユキロウ", "ヨウイチロウ",
+	"ミキ", "ヒデノリ", "キクコ", "トモ", "タツオ", "ユミ", "ミツホ", "マサシ", "コウジ", "コウイチ",
+	"トシツグ", "ヨシヒロ", "カメオ", "ユリコ", "ショウゾウ", "スイセン", "サヨコ", "カズヒサ", "シゲフミ", "ヨシカズ",
+	"ナツミ", "ユキオ", "ヨシヒロ", "トシヒロ", "トオル", "サトル", "ヨシヒロ", "カズヤ", "ノゾミ", "ユリ",
+	"コウジ", "マサエ", "マサヒロ", "チョウイチロウ", "ミサト", "キイチロウ", "カズキ", "ヨシミ", "ミツエ", "ツバサ",
+	"ヒデヒロ", "タダカズ", "ジョウスケ", "ノブヒロ", "ノリアキ", "ヨシユキ", "ヨシアキ", "マサオミ", "ヨウジ", "マツジロウ",
+	"アキオ", "ナガコ", "ヤスシ", "シゲツグ", "ミツエ", "ヨリフミ", "カツシ", "コウタロウ", "ユウコ", "キミノリ",
+	"カネノリ", "サヨコ", "ヨシハル", "ショウジ", "トモミ", "トモユキ", "ユウイチロウ", "モトノブ", "マキ", "ヤスジ",
+	"ユキオ", "ウンキチ", "リエ", "ムツオ", "ヒサチカ", "セイジロウ", "リエコ", "マサフミ", "ノブヤ", "エリ",
+	"ヤスヒロ", "ミチオ", "サダヒサ", "クニヒロ", "トモ", "クニヒロ", "ヨシカズ", "レイナ", "カツシ", "スミタカ",
+	"トモコ", "ジュンコ", "マキ", "マサユキ", "トモヨシ", "ヒロム", "タダユキ", "チコト", "シンイチ", "タケトシ",
+	"カネカズ", "カツジ", "トシヒコ", "ヒロム", "カズヤ", "ヤスヘイ", "サクコ", "セイジロウ", "アツシ", "マリ",
+	"マサトシ", "ゲンザブロウ", "アイサク", "チヨエ", "セツミ", "ヨシカズ", "アキオ", "ミキオ", "ツネカズ", "リエ",
+	"マキコ", "ハツミ", "シンイチ", "カメオ", "コウイチ", "シンヤ", "シゲイチ", "ユウジ", "ショウコ", "コウイチロウ",
+	"アカネ", "ユウコ", "ナガコ", "トモミ", "クニエ", "イクゾウ", "キクコ", "ショウジ", "ユミ", "リサ",
+	"エイハチロウ", "ハルミ", "レイコ", "リエコ", "ユウコ", "エリ", "カズヒロ", "セツミ", "ケイシ", "ユリ",
+	"ゲンザブロウ", "ヒロシ", "ツネジ", "シンキ", "ユウゾウ", "コウコ", "タダシ", "アツシ", "マスミ", "ユウコウ",
+	"マスゾウ", "ナツコ", "サンペイ", "フミユキ", "リサ", "ヨウコ", "キョウゾウ", "コウキ", "ヤスミ", "キュウサク",
+	"ミツジ", "サジュウロウ", "アイカ", "ジュンコ", "ヨシエ", "キンジ", "シンヤ", "ヨウコ", "ハルユキ", "タカヤ",
+	"マサミツ", "マサヤス", "マキコ", "ミツジ", "ヤスコ", "トモエ", "カツト", "カズヤ", "ナオタケ", "マキ",
+	"ユウミ", "トモ", "ヤスミツ", "ジュンタ", "マチコ", "ケイ", "アキオ", "トモエ", "エリ", "マサオ",
+	"ヤスヒロ", "テルヤ", "キヨノブ", "カズヤ", "ヨシオ", "ヨシツグ", "タイゾウ", "カズキ", "タカアキ", "アリカ",
+	"マツヨ", "シゲノブ", "リュウキチ", "ショウゾウ", "カクタロウ", "ケンイチ", "カツヒデ", "シンジ", "シゲヤ", "トシオ",
+	"スエタカ", "ムツオ", "ヒトキ", "タメイチロウ", "アヤコ", "ユキムラ", "タエコ", "テツヤ", "ナオミ", "マサオミ",
+	"ヨシフミ", "タイゾウ", "タクオ", "カメオ", "タダスケ", "レイナ", "シンジ", "キヨシゲ", "デンザブロウ", "マスミ",
+	"アヤ", "ミキ", "シゲオ", "ナミコ", "ナオユキ", "エイノスケ", "トシアキ", "タケイチ", "ウンキチ", "トクヒコ",
+	"マツヨ", "ゼンジ", "カズヒロ", "ノリユキ", "マサジ", "アイカ", "ウメタロウ", "トキジ", "カズキ", "エイゴ",
+	"ヨシアキ", "アキヒロ", "ヨシヒロ", "サヤカ", "コウゾウ", "ヒロキ", "ヨシアキ", "ノブヤ", "カツシ", "メグミ",
+	"シュウヘイ", "セイナ", "トモタカ", "アキラ", "ヨシカズ", "マスゾウ", "カヨコ", "アキノリ", "クニミ", "キョウゾウ",
+	"リエコ", "コハル", "セイジロウ", "ツネジ", "マサユキ", "マコト", "セイゴ", "サダジ", "カズトモ", "トモ",
+	"クニミ", "アキ", "シンキ", "キクハル", "ヨウコ", "オリエ", "ムネシ", "フユキ", "トモアキ", "コウジ",
+	"ヨウイチロウ", "マコト", "ミキ", "キミオ", "マサノブ", "サチミ", "ヒサノリ", "ハナヨ", "ジョウスケ", "キヨタカ",
+}
+
+// 候補者の姓
+var candidateLastNames = []string{
+	"佐藤", "鈴木", "高橋", "田中", "渡辺", "伊藤",
+}
+
+// 候補者の名
+var candidateFirstNames = []string{
+	"一郎", "次郎", "三郎", "四郎", "五郎",
+}
+
+// 政党
+var politicalParties = []string{
+	"国民元気党", "国民10人大活躍党", "夢実現党", "国民平和党",
+}
+
+// サンプル投票の投票理由
+var sampleKeywords = []string{
+	"誠実",
+	"親戚",
+	"親近感",
+	"柔軟な対応",
+	"日本酒はもっと値上げしても良いと思います。稀少性に合った値付けになっていないし、価格が上がれば、資本的にも、人的にも、参入が増えて、優勝劣敗がすすむかと。",
+	"ちょーーーーうまいよ！！！",
+	"エバラの年間売上が500億円で、うち焼き肉のタレが半分ほど。ライトノベル市場はORICONによると文庫が220億円、近年急速に拡大している四六判なども含めると350億円くらいとのこと",
+}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Config is the shape of the generated dataset
+type Config struct {
+	Seed     int64
+	Users    int
+	Votes    int
+	MinVotes int
+	MaxVotes int
+	// 指定すると MinVotes, MaxVotes の代わりに、重みで選んだ範囲から投票数を決める
+	VoteBuckets       []VoteBucket
+	PrefectureWeights map[string]int
+}
+
+// VoteBucket is a range of votes per user chosen with Weight
+type VoteBucket struct {
+	Min    int
+	Max    int
+	Weight int
+}
+
+// User is a row of the users table
+type User struct {
+	ID       int
+	Name     string
+	Address  string
+	Mynumber string
+	Votes    int
+}
+
+// Candidate is a row of the candidates table
+type Candidate struct {
+	ID             int
+	Name           string
+	PoliticalParty string
+	Sex            string
+}
+
+// Vote is a row of the votes table
+type Vote struct {
+	UserID      int
+	CandidateID int
+	Keyword     string
+	Count       int
+}
+
+// Generator generates the same dataset for the same Config
+type Generator struct {
+	cfg Config
+	// 都道府県の累積の重み
+	prefWeights []int
+	// VoteBuckets の累積の重み
+	bucketWeights []int
+}
+
+// NewGenerator validates cfg
+func NewGenerator(cfg Config) (*Generator, error) {
+	if cfg.Users < 1 {
+		return nil, errors.New("users must be positive")
+	}
+	if cfg.MinVotes < 1 || cfg.MaxVotes < cfg.MinVotes {
+		return nil, errors.New("min-votes must be positive and not greater than max-votes")
+	}
+	g := &Generator{cfg: cfg}
+	bucketSum := 0
+	for _, b := range cfg.VoteBuckets {
+		if b.Min < 1 || b.Max < b.Min {
+			return nil, errors.New("vote distribution: min must be positive and not greater than max")
+		}
+		if b.Weight < 0 {
+			return nil, errors.New("vote distribution: negative weight")
+		}
+		bucketSum += b.Weight
+		g.bucketWeights = append(g.bucketWeights, bucketSum)
+	}
+	if len(cfg.VoteBuckets) > 0 && bucketSum == 0 {
+		return nil, errors.New("vote distribution: all weights are zero")
+	}
+	sum := 0
+	for _, p := range prefectures {
+		w, ok := cfg.PrefectureWeights[p]
+		if !ok {
+			w = 1
+		}
+		if w < 0 {
+			return nil, errors.New("negative weight for " + p)
+		}
+		sum += w
+		g.prefWeights = append(g.prefWeights, sum)
+	}
+	if sum == 0 {
+		return nil, errors.New("all prefecture weights are zero")
+	}
+	for p := range cfg.PrefectureWeights {
+		if !isPrefecture(p) {
+			return nil, errors.New("unknown prefecture: " + p)
+		}
+	}
+	return g, nil
+}
+
+// テーブルごとに乱数列を分けて、件数を変えても他のテーブルが変わらないようにする
+func (g *Generator) rand(table int64) *rand.Rand {
+	return rand.New(rand.NewSource(g.cfg.Seed*10 + table))
+}
+
+// Users calls emit for each user in id order
+func (g *Generator) Users(emit func(User) error) error {
+	r := g.rand(1)
+	for i := 0; i < g.cfg.Users; i++ {
+		u := User{
+			ID:      i + 1,
+			Name:    lastNames[r.Intn(len(lastNames))] + " " + firstNames[r.Intn(len(firstNames))],
+			Address: g.prefecture(r),
+			// 下2桁をランダムにして連番と分かりにくくする
+			Mynumber: strconv.Itoa(i*100 + r.Intn(100)),
+			Votes:    g.votes(r),
+		}
+		if err := emit(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Candidates returns all candidates in id order
+func (g *Generator) Candidates() []Candidate {
+	r := g.rand(2)
+	var cs []Candidate
+	for _, last := range candidateLastNames {
+		for _, first := range candidateFirstNames {
+			cs = append(cs, Candidate{
+				ID:             len(cs) + 1,
+				Name:           last + " " + first,
+				PoliticalParty: politicalParties[r.Intn(len(politicalParties))],
+				Sex:            []string{"男", "女"}[r.Intn(2)],
+			})
+		}
+	}
+	return cs
+}
+
+// Votes calls emit for each sample vote
+func (g *Generator) Votes(emit func(Vote) error) error {
+	r := g.rand(3)
+	for i := 0; i < g.cfg.Votes; i++ {
+		v := Vote{
+			UserID:      r.Intn(g.cfg.Users) + 1,
+			CandidateID: sampleCandidateID(r),
+			Keyword:     sampleKeywords[r.Intn(len(sampleKeywords))],
+			Count:       1,
+		}
+		if err := emit(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) votes(r *rand.Rand) int {
+	if len(g.bucketWeights) == 0 {
+		return r.Intn(g.cfg.MaxVotes-g.cfg.MinVotes+1) + g.cfg.MinVotes
+	}
+	n := r.Intn(g.bucketWeights[len(g.bucketWeights)-1])
+	i := sort.Search(len(g.bucketWeights), func(i int) bool { return g.bucketWeights[i] > n })
+	b := g.cfg.VoteBuckets[i]
+	return r.Intn(b.Max-b.Min+1) + b.Min
+}
+
+func (g *Generator) prefecture(r *rand.Rand) string {
+	n := r.Intn(g.prefWeights[len(g.prefWeights)-1])
+	i := sort.Search(len(g.prefWeights), func(i int) bool { return g.prefWeights[i] > n })
+	return prefectures[i]
+}
+
+// insert_sample_votes.rb と同じく一部の候補者に票を偏らせる
+func sampleCandidateID(r *rand.Rand) int {
+	switch r.Intn(6) {
+	case 0:
+		return 3
+	case 1:
+		return 19
+	case 2, 3:
+		return r.Intn(10) + 1
+	case 4:
+		return r.Intn(20) + 1
+	default:
+		return r.Intn(20) + 10
+	}
+}
+
+func isPrefecture(name string) bool {
+	for _, p := range prefectures {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// "10-50=7,51-200=3" の形式をパースする
+func parseVoteBuckets(s string) ([]VoteBucket, error) {
+	var buckets []VoteBucket
+	if s == "" {
+		return buckets, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) != 2 {
+			return nil, errors.New("invalid vote distribution: " + kv)
+		}
+		bounds := strings.SplitN(strings.TrimSpace(pair[0]), "-", 2)
+		if len(bounds) != 2 {
+			return nil, errors.New("invalid vote distribution: " + kv)
+		}
+		var b VoteBucket
+		var err1, err2, err3 error
+		b.Min, err1 = strconv.Atoi(bounds[0])
+		b.Max, err2 = strconv.Atoi(bounds[1])
+		b.Weight, err3 = strconv.Atoi(pair[1])
+		if err1 != nil || err2 != nil || err3 != nil {
+			return nil, errors.New("invalid vote distribution: " + kv)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// "東京都=10,大阪府=5" の形式をパースする
+func parsePrefectureWeights(s string) (map[string]int, error) {
+	weights := map[string]int{}
+	if s == "" {
+		return weights, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) != 2 {
+			return nil, errors.New("invalid prefecture weight: " + kv)
+		}
+		w, err := strconv.Atoi(pair[1])
+		if err != nil {
+			return nil, errors.New("invalid prefecture weight: " + kv)
+		}
+		weights[strings.TrimSpace(pair[0])] = w
+	}
+	return weights, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func generate(t *testing.T, cfg Config) ([]User, []Vote) {
+	g, err := NewGenerator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var users []User
+	var votes []Vote
+	if err := g.Users(func(u User) error { users = append(users, u); return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Votes(func(v Vote) error { votes = append(votes, v); return nil }); err != nil {
+		t.Fatal(err)
+	}
+	return users, votes
+}
+
+func TestGeneratorIsDeterministic(t *testing.T) {
+	cfg := Config{Seed: 1, Users: 1000, Votes: 1000, MinVotes: 10, MaxVotes: 200}
+	users1, votes1 := generate(t, cfg)
+	users2, votes2 := generate(t, cfg)
+	if !reflect.DeepEqual(users1, users2) || !reflect.DeepEqual(votes1, votes2) {
+		t.Error("the same seed generates different rows")
+	}
+
+	g, _ := NewGenerator(cfg)
+	if !reflect.DeepEqual(g.Candidates(), g.Candidates()) {
+		t.Error("the same seed generates different candidates")
+	}
+
+	cfg.Seed = 2
+	users3, _ := generate(t, cfg)
+	if reflect.DeepEqual(users1, users3) {
+		t.Error("another seed generates the same users")
+	}
+
+	// 件数を変えても他のテーブルは変わらない
+	cfg.Seed = 1
+	cfg.Votes = 10
+	users4, _ := generate(t, cfg)
+	if !reflect.DeepEqual(users1, users4) {
+		t.Error("the number of votes changes the users")
+	}
+}
+
+func TestGeneratorPrefectureWeights(t *testing.T) {
+	weights := map[string]int{}
+	for _, p := range prefectures {
+		weights[p] = 0
+	}
+	weights["東京都"] = 3
+	weights["大阪府"] = 1
+	users, _ := generate(t, Config{Seed: 1, Users: 4000, MinVotes: 1, MaxVotes: 1, PrefectureWeights: weights})
+
+	count := map[string]int{}
+	for _, u := range users {
+		count[u.Address]++
+	}
+	if len(count) != 2 {
+		t.Fatalf("users live in %d prefectures, want 2: %v", len(count), count)
+	}
+	if r := float64(count["東京都"]) / float64(len(users)); r < 0.72 || r > 0.78 {
+		t.Errorf("%.2f of the users live in 東京都, want 0.75", r)
+	}
+}
+
+func TestGeneratorVoteDistribution(t *testing.T) {
+	users, _ := generate(t, Config{Seed: 1, Users: 4000, MinVotes: 10, MaxVotes: 20})
+	for _, u := range users {
+		if u.Votes < 10 || u.Votes > 20 {
+			t.Fatalf("user %d has %d votes, want 10-20", u.ID, u.Votes)
+		}
+	}
+
+	buckets, err := parseVoteBuckets("1-1=9,100-200=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	users, _ = generate(t, Config{Seed: 1, Users: 4000, MinVotes: 10, MaxVotes: 20, VoteBuckets: buckets})
+	small := 0
+	for _, u := range users {
+		switch {
+		case u.Votes == 1:
+			small++
+		case u.Votes < 100 || u.Votes > 200:
+			t.Fatalf("user %d has %d votes, out of the distribution", u.ID, u.Votes)
+		}
+	}
+	if r := float64(small) / float64(len(users)); r < 0.87 || r > 0.93 {
+		t.Errorf("%.2f of the users have 1 vote, want 0.9", r)
+	}
+
+	for _, s := range []string{"10-50", "50=1", "a-b=1"} {
+		if _, err := parseVoteBuckets(s); err == nil {
+			t.Errorf("parseVoteBuckets(%q) is accepted", s)
+		}
+	}
+	if _, err := NewGenerator(Config{Users: 1, MinVotes: 1, MaxVotes: 1, VoteBuckets: []VoteBucket{{Min: 5, Max: 1, Weight: 1}}}); err == nil {
+		t.Error("a bucket with min > max is accepted")
+	}
+}
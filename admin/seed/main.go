@@ -0,0 +1,144 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Println(`Usage: ./seed [option]
+Options:
+  --seed	N	random seed; the same seed generates the same data (default: 1)
+  --users	N	number of users (default: 4000000)
+  --votes	N	number of sample votes (default: 0)
+  --min-votes	N	minimum votes per user (default: 10)
+  --max-votes	N	maximum votes per user (default: 200)
+  --vote-distribution	D	weighted ranges of votes per user such as "10-50=7,51-200=3" (overrides --min-votes and --max-votes)
+  --prefectures	W	prefecture weights such as "東京都=10,大阪府=5" (default: 1 for each)
+  --without-count		do not write the count column of votes, for a schema without "webapp migrate up"
+  --format	F	sql, csv or mysql (default: sql)
+  --out	PATH	output file for sql (default: stdout), output directory for csv (default: .)
+  --dsn	DSN	MySQL DSN for mysql (default: ishocon:ishocon@/ishocon2)`)
+	}
+
+	var (
+		seed     = flag.Int64("seed", 1, "")
+		users    = flag.Int("users", 4000000, "")
+		votes    = flag.Int("votes", 0, "")
+		minVotes = flag.Int("min-votes", 10, "")
+		maxVotes = flag.Int("max-votes", 200, "")
+		voteDist = flag.String("vote-distribution", "", "")
+		prefs    = flag.String("prefectures", "", "")
+		noCount  = flag.Bool("without-count", false, "")
+		format   = flag.String("format", "sql", "")
+		out      = flag.String("out", "", "")
+		dsn      = flag.String("dsn", "ishocon:ishocon@/ishocon2", "")
+	)
+	flag.Parse()
+
+	weights, err := parsePrefectureWeights(*prefs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	buckets, err := parseVoteBuckets(*voteDist)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g, err := NewGenerator(Config{
+		Seed:              *seed,
+		Users:             *users,
+		Votes:             *votes,
+		MinVotes:          *minVotes,
+		MaxVotes:          *maxVotes,
+		VoteBuckets:       buckets,
+		PrefectureWeights: weights,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sink, err := newSink(*format, *out, *dsn)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := seedAll(g, sink, !*noCount); err != nil {
+		log.Fatal(err)
+	}
+	if err := sink.Close(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newSink(format string, out string, dsn string) (Sink, error) {
+	switch format {
+	case "sql":
+		if out == "" {
+			return NewSQLSink(os.Stdout), nil
+		}
+		f, err := os.Create(out)
+		if err != nil {
+			return nil, err
+		}
+		return NewSQLSink(f), nil
+	case "csv":
+		if out == "" {
+			out = "."
+		}
+		return NewCSVSink(out)
+	case "mysql":
+		return NewMySQLSink(dsn)
+	}
+	return nil, fmt.Errorf("unknown format: %s", format)
+}
+
+// withCount が false なら votes の count 列を書かず、1行1票にする (migrate up 前の init.sql のスキーマ)
+func seedAll(g *Generator, sink Sink, withCount bool) error {
+	log.Print("users を作成しています")
+	if err := sink.Begin("users", []string{"id", "name", "address", "mynumber", "votes"}); err != nil {
+		return err
+	}
+	err := g.Users(func(u User) error {
+		return sink.Row(u.ID, u.Name, u.Address, u.Mynumber, u.Votes)
+	})
+	if err != nil {
+		return err
+	}
+	if err := sink.End(); err != nil {
+		return err
+	}
+
+	log.Print("candidates を作成しています")
+	if err := sink.Begin("candidates", []string{"id", "name", "political_party", "sex"}); err != nil {
+		return err
+	}
+	for _, c := range g.Candidates() {
+		if err := sink.Row(c.ID, c.Name, c.PoliticalParty, c.Sex); err != nil {
+			return err
+		}
+	}
+	if err := sink.End(); err != nil {
+		return err
+	}
+
+	log.Print("votes を作成しています")
+	columns := []string{"user_id", "candidate_id", "keyword"}
+	if withCount {
+		columns = append(columns, "count")
+	}
+	if err := sink.Begin("votes", columns); err != nil {
+		return err
+	}
+	err = g.Votes(func(v Vote) error {
+		if !withCount {
+			return sink.Row(v.UserID, v.CandidateID, v.Keyword)
+		}
+		return sink.Row(v.UserID, v.CandidateID, v.Keyword, v.Count)
+	})
+	if err != nil {
+		return err
+	}
+	return sink.End()
+}
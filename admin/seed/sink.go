@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// 1回の INSERT でまとめて入れる行数
+const batchSize = 10000
+
+// Sink writes generated rows of a table
+type Sink interface {
+	Begin(table string, columns []string) error
+	Row(values ...interface{}) error
+	End() error
+	Close() error
+}
+
+// SQLSink writes SQL statements which replace the contents of the tables
+type SQLSink struct {
+	w       *bufio.Writer
+	closer  io.Closer
+	table   string
+	columns []string
+	rows    int
+}
+
+// NewSQLSink writes to w
+func NewSQLSink(w io.WriteCloser) *SQLSink {
+	return &SQLSink{w: bufio.NewWriter(w), closer: w}
+}
+
+// Begin implements Sink
+func (s *SQLSink) Begin(table string, columns []string) error {
+	s.table = table
+	s.columns = columns
+	s.rows = 0
+	_, err := fmt.Fprintf(s.w, "DELETE FROM %s;\nALTER TABLE %s AUTO_INCREMENT = 1;\n", table, table)
+	return err
+}
+
+// Row implements Sink
+func (s *SQLSink) Row(values ...interface{}) error {
+	if s.rows%batchSize == 0 {
+		if s.rows > 0 {
+			s.w.WriteString(";\n")
+		}
+		fmt.Fprintf(s.w, "INSERT INTO %s (%s) VALUES\n", s.table, strings.Join(s.columns, ", "))
+	} else {
+		s.w.WriteString(",\n")
+	}
+	s.rows++
+
+	s.w.WriteString("(")
+	for i, v := range values {
+		if i > 0 {
+			s.w.WriteString(",")
+		}
+		switch v := v.(type) {
+		case int:
+			s.w.WriteString(strconv.Itoa(v))
+		default:
+			s.w.WriteString(quoteSQL(fmt.Sprint(v)))
+		}
+	}
+	_, err := s.w.WriteString(")")
+	return err
+}
+
+// End implements Sink
+func (s *SQLSink) End() error {
+	if s.rows > 0 {
+		s.w.WriteString(";\n")
+	}
+	return s.w.Flush()
+}
+
+// Close implements Sink
+func (s *SQLSink) Close() error {
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	return s.closer.Close()
+}
+
+func quoteSQL(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\x00", `\0`)
+	return "'" + r.Replace(s) + "'"
+}
+
+// CSVSink writes <table>.csv files in a directory.
+// The files can be loaded with LOAD DATA INFILE or by the benchmarker.
+type CSVSink struct {
+	dir  string
+	file *os.File
+	w    *csv.Writer
+}
+
+// NewCSVSink writes to dir
+func NewCSVSink(dir string) (*CSVSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &CSVSink{dir: dir}, nil
+}
+
+// Begin implements Sink
+func (s *CSVSink) Begin(table string, columns []string) error {
+	f, err := os.Create(filepath.Join(s.dir, table+".csv"))
+	if err != nil {
+		return err
+	}
+	s.file = f
+	s.w = csv.NewWriter(f)
+	return nil
+}
+
+// Row implements Sink
+func (s *CSVSink) Row(values ...interface{}) error {
+	rec := make([]string, len(values))
+	for i, v := range values {
+		rec[i] = fmt.Sprint(v)
+	}
+	return s.w.Write(rec)
+}
+
+// End implements Sink
+func (s *CSVSink) End() error {
+	s.w.Flush()
+	if err := s.w.Error(); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
+
+// Close implements Sink
+func (s *CSVSink) Close() error {
+	return nil
+}
+
+// MySQLSink inserts rows into the database directly
+type MySQLSink struct {
+	db      *sql.DB
+	table   string
+	columns []string
+	args    []interface{}
+	rows    int
+}
+
+// NewMySQLSink connects to the database with dsn
+func NewMySQLSink(dsn string) (*MySQLSink, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &MySQLSink{db: db}, nil
+}
+
+// Begin implements Sink
+func (s *MySQLSink) Begin(table string, columns []string) error {
+	s.table = table
+	s.columns = columns
+	s.args = s.args[:0]
+	s.rows = 0
+	if _, err := s.db.Exec("DELETE FROM " + table); err != nil {
+		return err
+	}
+	_, err := s.db.Exec("ALTER TABLE " + table + " AUTO_INCREMENT = 1")
+	return err
+}
+
+// Row implements Sink
+func (s *MySQLSink) Row(values ...interface{}) error {
+	s.args = append(s.args, values...)
+	s.rows++
+	if s.rows == batchSize {
+		return s.flush()
+	}
+	return nil
+}
+
+// End implements Sink
+func (s *MySQLSink) End() error {
+	return s.flush()
+}
+
+// Close implements Sink
+func (s *MySQLSink) Close() error {
+	return s.db.Close()
+}
+
+func (s *MySQLSink) flush() error {
+	if s.rows == 0 {
+		return nil
+	}
+	row := "(?" + strings.Repeat(",?", len(s.columns)-1) + ")"
+	query := "INSERT INTO " + s.table + " (" + strings.Join(s.columns, ", ") + ") VALUES " +
+		row + strings.Repeat(","+row, s.rows-1)
+	_, err := s.db.Exec(query, s.args...)
+	s.args = s.args[:0]
+	s.rows = 0
+	return err
+}
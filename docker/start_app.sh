@@ -8,5 +8,12 @@ sudo mysql -u root -pishocon -e "CREATE USER IF NOT EXISTS ishocon IDENTIFIED BY
 sudo mysql -u root -pishocon -e 'GRANT ALL ON *.* TO ishocon;' && \
 cd ~/data && tar -jxvf ishocon2.dump.tar.bz2 && sudo mysql -u root -pishocon ishocon2 < ~/data/ishocon2.dump
 
+# Go 実装はダンプに無い votes.count 列を使うので、ビルド済みならマイグレーションを適用する
+if [ -x ~/webapp/go/webapp ]; then
+  (cd ~/webapp/go && ./webapp migrate up)
+else
+  echo 'Go 実装を使う場合は、ビルドした後に ~/webapp/go で ./webapp migrate up を実行してください'
+fi
+
 echo 'setup completed.'
 tail -f /dev/null
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// POST /vote の結果のメッセージをアクセスログに残すためのキー
+const voteMessageKey = "voteMessage"
+
+// AccessLog is a line of the access log
+type AccessLog struct {
+	Time        string  `json:"time"`
+	RequestID   string  `json:"request_id"`
+	Method      string  `json:"method"`
+	Route       string  `json:"route"`
+	Path        string  `json:"path"`
+	Status      int     `json:"status"`
+	LatencyMS   float64 `json:"latency_ms"`
+	Queries     int     `json:"queries"`
+	DBTimeMS    float64 `json:"db_time_ms"`
+	VoteMessage string  `json:"vote_message,omitempty"`
+}
+
+// AccessLogger writes AccessLog as JSON Lines.
+// Only SampleRate of the requests are written, but 5xx responses are always written.
+type AccessLogger struct {
+	mu         sync.Mutex
+	w          io.Writer
+	SampleRate float64
+}
+
+// NewAccessLogger returns a logger writing every request to w
+func NewAccessLogger(w io.Writer) *AccessLogger {
+	return &AccessLogger{w: w, SampleRate: 1}
+}
+
+func (l *AccessLogger) middleware(routes *routeTable) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		if c.Writer.Status() < 500 && l.SampleRate < 1 && rand.Float64() >= l.SampleRate {
+			return
+		}
+		stats := queryStatsFrom(c)
+		if stats == nil {
+			stats = &QueryStats{}
+		}
+		l.write(AccessLog{
+			Time:        start.Format(time.RFC3339Nano),
+			RequestID:   getRequestID(c),
+			Method:      c.Request.Method,
+			Route:       routes.of(c),
+			Path:        c.Request.URL.Path,
+			Status:      c.Writer.Status(),
+			LatencyMS:   float64(time.Since(start)) / float64(time.Millisecond),
+			Queries:     stats.Queries(),
+			DBTimeMS:    float64(stats.DBTime()) / float64(time.Millisecond),
+			VoteMessage: c.GetString(voteMessageKey),
+		})
+	}
+}
+
+func (l *AccessLogger) write(entry AccessLog) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		log.Print(err)
+	}
+}
+
+// openAccessLog opens the sink of the access log: "stdout", "stderr" or a file path.
+// A file is rotated when it grows over maxSize bytes, keeping maxBackups old files. maxSize 0 disables rotation.
+func openAccessLog(sink string, maxSize int64, maxBackups int) (io.Writer, error) {
+	switch sink {
+	case "", "stdout":
+		return os.Stdout, nil
+	case "stderr":
+		return os.Stderr, nil
+	}
+	f := &rotatingFile{path: sink, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// rotatingFile renames path to path.1, path.1 to path.2, ... when the file is larger than maxSize
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = fi.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if f.maxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", f.path, f.maxBackups))
+		for i := f.maxBackups - 1; i >= 1; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", f.path, i), fmt.Sprintf("%s.%d", f.path, i+1))
+		}
+		if err := os.Rename(f.path, f.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return err
+	}
+	return f.open()
+}
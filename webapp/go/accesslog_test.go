@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAccessLog(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	var buf bytes.Buffer
+	app.accessLog = NewAccessLogger(&buf)
+
+	postVote(app, voteForm(testUsers[0], "佐藤 一郎", "", "1"))
+	serve(app, httptest.NewRequest("GET", "/candidates/2", nil))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+	var vote, candidate AccessLog
+	if err := json.Unmarshal([]byte(lines[0]), &vote); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &candidate); err != nil {
+		t.Fatal(err)
+	}
+	if vote.Route != "/vote" || vote.Method != "POST" || vote.Status != 200 || vote.VoteMessage != "投票理由を記入してください" || vote.RequestID == "" {
+		t.Errorf("unexpected log of POST /vote: %s", lines[0])
+	}
+	if candidate.Route != "/candidates/:candidateID" || candidate.Path != "/candidates/2" || candidate.VoteMessage != "" {
+		t.Errorf("unexpected log of GET /candidates/2: %s", lines[1])
+	}
+}
+
+func TestAccessLogSampling(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	var buf bytes.Buffer
+	app.accessLog = NewAccessLogger(&buf)
+	app.accessLog.SampleRate = 0
+
+	serve(app, httptest.NewRequest("GET", "/", nil))
+	if buf.Len() != 0 {
+		t.Errorf("a sampled out request is logged: %s", buf.String())
+	}
+
+	app.votes = failingVoteStore{store}
+	postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1"))
+	if !strings.Contains(buf.String(), `"status":500`) {
+		t.Errorf("a 500 response is not logged: %q", buf.String())
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "accesslog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "access.log")
+
+	w, err := openAccessLog(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := w.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, want := range map[string]string{
+		"access.log":   "fourth\n",
+		"access.log.1": "third\n",
+		"access.log.2": "second\n",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(b) != want {
+			t.Errorf("%s = %q, want %q", name, b, want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("more than 2 backups are kept")
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// benchmarkerFixture returns the 30 candidates the benchmarker votes for and enough users for the validation phase
+func benchmarkerFixture() ([]User, []Candidate) {
+	parties := []string{"国民元気党", "国民10人大活躍党", "夢実現党", "国民平和党"}
+	var candidates []Candidate
+	for _, last := range []string{"佐藤", "鈴木", "高橋", "田中", "渡辺", "伊藤"} {
+		for _, first := range []string{"一郎", "次郎", "三郎", "四郎", "五郎"} {
+			id := len(candidates) + 1
+			candidates = append(candidates, Candidate{
+				ID:             id,
+				Name:           last + " " + first,
+				PoliticalParty: parties[id%len(parties)],
+				Sex:            []string{"男", "女"}[id%2],
+			})
+		}
+	}
+
+	var users []User
+	for id := 1; id <= 1000; id++ {
+		users = append(users, User{
+			ID:       id,
+			Name:     "投票者 " + strconv.Itoa(id),
+			Address:  "東京都",
+			MyNumber: strconv.Itoa(100000000 + id),
+			Votes:    10,
+		})
+	}
+	return users, candidates
+}
+
+func writeJSONL(t *testing.T, path string, rows []interface{}) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestBenchmarkerValidation runs the validation phase of admin/benchmarker against the webapp on the in-memory store.
+// The benchmarker is another main package with its own dependencies, so it is built and run with --validate-only
+// (admin/benchmarker tests its Validate function in process). The test is skipped if the benchmarker cannot be built.
+func TestBenchmarkerValidation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and runs the benchmarker")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command is not found")
+	}
+
+	dir, err := ioutil.TempDir("", "ishocon2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	bench := filepath.Join(dir, "benchmark")
+	build := exec.Command("go", "build", "-o", bench, ".")
+	build.Dir = filepath.Join("..", "..", "admin", "benchmarker")
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Skipf("cannot build the benchmarker, are its dependencies installed? %s\n%s", err, out)
+	}
+
+	users, candidates := benchmarkerFixture()
+	var userRows, candidateRows []interface{}
+	for _, u := range users {
+		userRows = append(userRows, map[string]interface{}{
+			"id": u.ID, "name": u.Name, "address": u.Address, "mynumber": u.MyNumber, "votes": u.Votes,
+		})
+	}
+	for _, c := range candidates {
+		candidateRows = append(candidateRows, c)
+	}
+	usersPath := filepath.Join(dir, "users.jsonl")
+	candidatesPath := filepath.Join(dir, "candidates.jsonl")
+	writeJSONL(t, usersPath, userRows)
+	writeJSONL(t, candidatesPath, candidateRows)
+
+	for _, tt := range []struct {
+		name         string
+		voteRedirect bool
+		csrf         bool
+	}{
+		{"render", false, false},
+		{"redirect", true, false},
+		{"csrf", false, true},
+		{"redirect with csrf", true, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore(users, candidates)
+			app := NewApp(store, store, store)
+			app.accessLog = NewAccessLogger(ioutil.Discard)
+			app.voteRedirect = tt.voteRedirect
+			app.csrf = tt.csrf
+			if err := app.reload(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			srv := httptest.NewServer(app.Router())
+			defer srv.Close()
+
+			args := []string{
+				"--target", srv.URL,
+				"--validate-only",
+				"--users", usersPath,
+				"--candidates", candidatesPath,
+				"--seed", "1",
+			}
+			if tt.csrf {
+				args = append(args, "--csrf")
+			}
+			cmd := exec.Command(bench, args...)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("validation failed: %s\n%s", err, out)
+			}
+		})
+	}
+}
@@ -2,124 +2,76 @@ package main
 
 import (
 	"context"
+	"database/sql"
 )
 
 // Candidate Model
 type Candidate struct {
-	ID             int
-	Name           string
-	PoliticalParty string
-	Sex            string
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	PoliticalParty string `json:"political_party"`
+	Sex            string `json:"sex"`
 }
 
 // CandidateElectionResult type
 type CandidateElectionResult struct {
-	ID             int
-	Name           string
-	PoliticalParty string
-	Sex            string
-	VoteCount      int
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	PoliticalParty string `json:"political_party"`
+	Sex            string `json:"sex"`
+	VoteCount      int    `json:"vote_count"`
 }
 
 // PartyElectionResult type
 type PartyElectionResult struct {
-	PoliticalParty string
-	VoteCount      int
+	PoliticalParty string `json:"political_party"`
+	VoteCount      int    `json:"vote_count"`
 }
 
-func getAllCandidate(ctx context.Context) (candidates []Candidate) {
-	rows, err := db.QueryContext(ctx, "SELECT * FROM candidates")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		c := Candidate{}
-		err = rows.Scan(&c.ID, &c.Name, &c.PoliticalParty, &c.Sex)
-		if err != nil {
-			panic(err.Error())
-		}
-		candidates = append(candidates, c)
-	}
-
-	return
+// GetAllCandidate implements CandidateStore
+func (s *MySQLStore) GetAllCandidate(ctx context.Context) ([]Candidate, error) {
+	return s.queryCandidates(ctx, "SELECT * FROM candidates")
 }
 
-func getCandidate(ctx context.Context, candidateID int) (c Candidate, err error) {
-	row := db.QueryRowContext(ctx, "SELECT * FROM candidates WHERE id = ?", candidateID)
+// GetCandidate implements CandidateStore
+func (s *MySQLStore) GetCandidate(ctx context.Context, candidateID int) (c Candidate, err error) {
+	row := s.db.QueryRowContext(ctx, "SELECT * FROM candidates WHERE id = ?", candidateID)
 	err = row.Scan(&c.ID, &c.Name, &c.PoliticalParty, &c.Sex)
-
+	if err == sql.ErrNoRows {
+		err = errNotFound
+	}
 	return
 }
 
-func getCandidateByName(ctx context.Context, name string) (c Candidate, err error) {
-	row := db.QueryRowContext(ctx, "SELECT * FROM candidates WHERE name = ?", name)
+// GetCandidateByName implements CandidateStore
+func (s *MySQLStore) GetCandidateByName(ctx context.Context, name string) (c Candidate, err error) {
+	row := s.db.QueryRowContext(ctx, "SELECT * FROM candidates WHERE name = ?", name)
 	err = row.Scan(&c.ID, &c.Name, &c.PoliticalParty, &c.Sex)
+	if err == sql.ErrNoRows {
+		err = errNotFound
+	}
 	return
 }
 
-func getAllPartyName(ctx context.Context) (partyNames []string) {
-	rows, err := db.QueryContext(ctx, "SELECT political_party FROM candidates GROUP BY political_party")
-	if err != nil {
-		panic(err.Error())
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var name string
-		err = rows.Scan(&name)
-		if err != nil {
-			panic(err.Error())
-		}
-		partyNames = append(partyNames, name)
-	}
-
-	return
+// GetCandidatesByPoliticalParty implements CandidateStore
+func (s *MySQLStore) GetCandidatesByPoliticalParty(ctx context.Context, party string) ([]Candidate, error) {
+	return s.queryCandidates(ctx, "SELECT * FROM candidates WHERE political_party = ?", party)
 }
 
-func getCandidatesByPoliticalParty(ctx context.Context, party string) (candidates []Candidate) {
-	rows, err := db.QueryContext(ctx, "SELECT * FROM candidates WHERE political_party = ?", party)
+func (s *MySQLStore) queryCandidates(ctx context.Context, query string, args ...interface{}) ([]Candidate, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 	defer rows.Close()
 
+	candidates := []Candidate{}
 	for rows.Next() {
 		c := Candidate{}
-		err = rows.Scan(&c.ID, &c.Name, &c.PoliticalParty, &c.Sex)
-		if err != nil {
-			panic(err.Error())
+		if err := rows.Scan(&c.ID, &c.Name, &c.PoliticalParty, &c.Sex); err != nil {
+			return nil, err
 		}
 		candidates = append(candidates, c)
 	}
-
-	return
-}
-
-func getElectionResult(ctx context.Context) (result []CandidateElectionResult) {
-	rows, err := db.QueryContext(ctx, `
-		SELECT c.id, c.name, c.political_party, c.sex, IFNULL(v.count, 0)
-		FROM candidates AS c
-		LEFT OUTER JOIN
-	  	(SELECT candidate_id, COUNT(*) AS count
-	  	FROM votes
-	  	GROUP BY candidate_id) AS v
-		ON c.id = v.candidate_id
-		ORDER BY v.count DESC`)
-	if err != nil {
-		panic(err.Error())
-	}
-	defer rows.Close()
-
-	for rows.Next() {
-		r := CandidateElectionResult{}
-		err = rows.Scan(&r.ID, &r.Name, &r.PoliticalParty, &r.Sex, &r.VoteCount)
-		if err != nil {
-			panic(err.Error())
-		}
-		result = append(result, r)
-	}
-
-	return
+	return candidates, rows.Err()
 }
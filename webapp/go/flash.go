@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// POST /vote の結果を GET /vote に渡す flash のキー
+const voteFlashKey = "vote"
+
+// addFlash saves message in the session so that the next request can show it
+func addFlash(c *gin.Context, message string) error {
+	session := sessions.Default(c)
+	session.AddFlash(message, voteFlashKey)
+	return session.Save()
+}
+
+// popFlash returns the message saved by addFlash and removes it from the session.
+// It returns "" if there is no message.
+func popFlash(c *gin.Context) (string, error) {
+	session := sessions.Default(c)
+	flashes := session.Flashes(voteFlashKey)
+	if len(flashes) == 0 {
+		return "", nil
+	}
+	if err := session.Save(); err != nil {
+		return "", err
+	}
+	message, _ := flashes[len(flashes)-1].(string)
+	return message, nil
+}
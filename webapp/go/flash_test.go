@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// getWithCookies sends GET path with the cookies set by the previous response
+func getWithCookies(app *App, path string, prev *httptest.ResponseRecorder) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", path, nil)
+	for _, cookie := range prev.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	return serve(app, req)
+}
+
+func TestVoteRedirect(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	app.voteRedirect = true
+
+	w := postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "2"))
+	if w.Code != http.StatusSeeOther || w.Header().Get("Location") != "/vote" {
+		t.Fatalf("POST /vote = %d Location %q, want 303 to /vote", w.Code, w.Header().Get("Location"))
+	}
+	if n := app.tally.VoteCount(1); n != 2 {
+		t.Errorf("votes = %d, want 2", n)
+	}
+
+	w = getWithCookies(app, "/vote", w)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Fatalf("GET /vote after the redirect = %d, does not show the message", w.Code)
+	}
+
+	// flash は1度だけ表示する
+	w = getWithCookies(app, "/vote", w)
+	if strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Error("the message is shown again")
+	}
+
+	// 投票の処理まで進んだエラーはリダイレクトする
+	w = postVote(app, voteForm(testUsers[0], "田中 一郎", "誠実さ", "1"))
+	if w.Code != http.StatusSeeOther {
+		t.Fatalf("POST /vote with an unknown candidate = %d, want 303", w.Code)
+	}
+	if w = getWithCookies(app, "/vote", w); !strings.Contains(w.Body.String(), "候補者を正しく記入してください") {
+		t.Error("the error message is not shown after the redirect")
+	}
+
+	// 400 と 429 はステータスが分かるようにリダイレクトしない
+	w = postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "abc"))
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "投票数を正しく記入してください") {
+		t.Errorf("POST /vote with a malformed vote count = %d, want 400 with the message", w.Code)
+	}
+	app.limiter = NewRateLimiter(RateLimitConfig{IPRate: 0.001, IPBurst: 1}, NewMemoryLimiterStore())
+	postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1"))
+	w = postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1"))
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "投票が多すぎます") {
+		t.Errorf("POST /vote over the rate limit = %d, want 429 with the message", w.Code)
+	}
+	app.limiter = nil
+
+	// リダイレクトするときは候補者一覧を引かない
+	assertQueryBudget(t, app, voteRequest(), 4)
+
+	// 500 はリダイレクトしない
+	app.votes = failingVoteStore{store}
+	if w := postVote(app, voteForm(testUsers[1], "佐藤 一郎", "誠実さ", "1")); w.Code != http.StatusInternalServerError {
+		t.Errorf("POST /vote with a store error = %d, want 500", w.Code)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+const pingTimeout = 2 * time.Second
+
+// checkReady checks the stores, the templates and the tally. It returns "ok" or the error for each of them.
+func (a *App) checkReady(ctx context.Context, templates *Templates) (map[string]string, bool) {
+	checks := map[string]string{}
+	ready := true
+	set := func(name string, err error) {
+		if err != nil {
+			checks[name] = err.Error()
+			ready = false
+		} else {
+			checks[name] = "ok"
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+	var dbErr error
+	for _, s := range []interface{}{a.users, a.candidates, a.votes} {
+		if p, ok := s.(Pinger); ok && dbErr == nil {
+			dbErr = p.Ping(ctx)
+		}
+	}
+	set("db", dbErr)
+
+	if templates == nil || templates.Pages() == 0 {
+		set("templates", errNotLoaded)
+	} else {
+		set("templates", nil)
+	}
+
+	if !a.tally.Loaded() {
+		set("tally", errNotLoaded)
+	} else {
+		set("tally", nil)
+	}
+	return checks, ready
+}
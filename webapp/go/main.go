@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"database/sql"
-	"html/template"
+	"flag"
+	"log"
 	"net/http"
 	"os"
-	"sort"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/contrib/static"
@@ -40,141 +44,275 @@ func main() {
 	user := getEnv("ISHOCON2_DB_USER", "ishocon")
 	pass := getEnv("ISHOCON2_DB_PASSWORD", "ishocon")
 	dbname := getEnv("ISHOCON2_DB_NAME", "ishocon2")
-	db, _ = sql.Open(driverName, user+":"+pass+"@/"+dbname)
+	var err error
+	db, err = sql.Open(driverName, user+":"+pass+"@/"+dbname)
+	if err != nil {
+		log.Fatal(err)
+	}
 	db.SetMaxIdleConns(5)
 
+	// ./webapp migrate up|down|status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		migrateMain(os.Args[2:])
+		return
+	}
+
+	var cfg ServerConfig
+	var metricsAddr, accessLog string
+	var accessLogMaxSize int64
+	var accessLogMaxBackups int
+	var accessLogSample float64
+	var voteRedirect, csrf bool
+	var session SessionConfig
+	var sessionKeyFile string
+	var rateLimit RateLimitConfig
+	var trustedProxies string
+	flag.StringVar(&cfg.Addr, "listen", getEnv("ISHOCON2_LISTEN", ":8080"), "host:port or unix:/path/to.sock")
+	flag.DurationVar(&cfg.ReadTimeout, "read-timeout", 10*time.Second, "maximum duration for reading a request")
+	flag.DurationVar(&cfg.WriteTimeout, "write-timeout", 30*time.Second, "maximum duration for writing a response")
+	flag.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", 30*time.Second, "time to wait for in-flight requests on SIGTERM")
+	flag.StringVar(&metricsAddr, "metrics-listen", getEnv("ISHOCON2_METRICS_LISTEN", ""), "serve /metrics on this address, e.g. 127.0.0.1:9100 (disabled if empty)")
+	flag.StringVar(&accessLog, "access-log", getEnv("ISHOCON2_ACCESS_LOG", "stdout"), "stdout, stderr or a file path")
+	flag.Int64Var(&accessLogMaxSize, "access-log-max-size", 100, "rotate the access log file over this size in MB (0 disables rotation)")
+	flag.IntVar(&accessLogMaxBackups, "access-log-max-backups", 5, "number of rotated access log files to keep")
+	flag.Float64Var(&accessLogSample, "access-log-sample", 1, "fraction of requests to log, 5xx are always logged")
+	flag.BoolVar(&voteRedirect, "vote-redirect", os.Getenv("ISHOCON2_VOTE_REDIRECT") == "1", "redirect POST /vote to GET /vote with the result in the session (Post/Redirect/Get)")
+	flag.BoolVar(&csrf, "csrf", os.Getenv("ISHOCON2_CSRF") == "1", "require the CSRF token of the vote form in POST /vote")
+	flag.StringVar(&sessionKeyFile, "session-key-file", getEnv("ISHOCON2_SESSION_KEY_FILE", ""), "file of the keys to sign the session cookie, one per line, the current key first")
+	flag.BoolVar(&session.Secure, "session-secure", os.Getenv("ISHOCON2_SESSION_SECURE") == "1", "send the session cookie only over https")
+	flag.StringVar(&session.SameSite, "session-samesite", getEnv("ISHOCON2_SESSION_SAMESITE", "Lax"), "SameSite attribute of the session cookie: Lax, Strict, None or empty")
+	flag.Float64Var(&rateLimit.IPRate, "rate-limit-ip", 0, "POST /vote per second per client IP (0 disables the limit)")
+	flag.IntVar(&rateLimit.IPBurst, "rate-limit-ip-burst", 20, "burst of POST /vote per client IP")
+	flag.Float64Var(&rateLimit.MyNumberRate, "rate-limit-mynumber", 0, "POST /vote per second per mynumber (0 disables the limit)")
+	flag.IntVar(&rateLimit.MyNumberBurst, "rate-limit-mynumber-burst", 5, "burst of POST /vote per mynumber")
+	flag.IntVar(&rateLimit.MaxFailures, "lockout-failures", 0, "lock out a client IP or a mynumber after this many failed identity checks (0 disables the lockout)")
+	flag.DurationVar(&rateLimit.Lockout, "lockout-duration", 15*time.Minute, "how long failed identity checks are counted and a lockout lasts")
+	flag.StringVar(&trustedProxies, "trusted-proxies", getEnv("ISHOCON2_TRUSTED_PROXIES", "127.0.0.1,::1"), "IPs or CIDRs of the proxies whose X-Real-IP is the client IP, separated by commas")
+	flag.Parse()
+
+	rateLimit.TrustedProxies, err = parseTrustedProxies(trustedProxies)
+	if err != nil {
+		log.Fatalf("invalid --trusted-proxies: %s", err)
+	}
+	if !validSameSite(session.SameSite) {
+		log.Fatalf("invalid --session-samesite: %q", session.SameSite)
+	}
+	// 鍵ファイルが無ければ ISHOCON2_SESSION_SECRET (カンマ区切り) を使う
+	session.Keys, err = loadSessionKeys(sessionKeyFile, os.Getenv("ISHOCON2_SESSION_SECRET"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if session.Keys == nil {
+		log.Print("session key is not set, using a random key: sessions are lost on restart")
+		session.Keys = [][]byte{randomSessionKey()}
+	}
+
+	store := NewMySQLStore(db)
+	app := NewApp(store, store, store)
+	if err := app.reload(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	w, err := openAccessLog(accessLog, accessLogMaxSize*1024*1024, accessLogMaxBackups)
+	if err != nil {
+		log.Fatal(err)
+	}
+	app.accessLog = NewAccessLogger(w)
+	app.accessLog.SampleRate = accessLogSample
+	app.debugHeaders = os.Getenv("ISHOCON2_DEBUG_HEADERS") == "1"
+	app.voteRedirect = voteRedirect
+	app.csrf = csrf
+	app.session = session
+	if rateLimit.Enabled() {
+		app.limiter = NewRateLimiter(rateLimit, NewMemoryLimiterStore())
+	}
+
+	// /metrics は nginx を通らない別のアドレスでだけ公開するので、ベンチマーカーからは見えない
+	if metricsAddr != "" {
+		l, err := listen(metricsAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", app.metrics.Handler(store))
+		go http.Serve(l, mux)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
+	if err := runServer(app.Router(), cfg, stop); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// App serves the webapp from the stores
+type App struct {
+	users      UserStore
+	candidates CandidateStore
+	votes      VoteStore
+	tally      *Tally
+	metrics    *Metrics
+	accessLog  *AccessLogger
+	routes     *routeTable
+	// X-Query-Count, X-DB-Time ヘッダを返す
+	debugHeaders bool
+	// POST /vote の結果を flash にして GET /vote へリダイレクトする。
+	// false だと今まで通り POST /vote で直接 vote.tmpl を返す (ベンチマーカーの DOM チェックとの互換モード)
+	voteRedirect bool
+	// POST /vote で投票フォームの CSRF トークンを確認する
+	csrf    bool
+	session SessionConfig
+	// nil だと POST /vote を制限しない
+	limiter *RateLimiter
+}
+
+// NewApp returns an App. Call reload before serving so that the tally has the current votes.
+func NewApp(users UserStore, candidates CandidateStore, votes VoteStore) *App {
+	return &App{
+		users:      users,
+		candidates: candidates,
+		votes:      votes,
+		tally:      &Tally{},
+		metrics:    newMetrics(),
+		accessLog:  NewAccessLogger(os.Stdout),
+		routes:     &routeTable{},
+		session:    SessionConfig{Keys: [][]byte{randomSessionKey()}, SameSite: "Lax"},
+	}
+}
+
+func (a *App) reload(ctx context.Context) error {
+	return a.tally.Load(ctx, a.candidates, a.votes)
+}
+
+// Router returns the handler of all routes
+func (a *App) Router() *gin.Engine {
 	//gin.SetMode(gin.DebugMode)
 	gin.SetMode(gin.ReleaseMode)
 
-	r := gin.Default()
+	r := gin.New()
+	r.Use(requestID(), countQueries(a.debugHeaders), a.metrics.middleware(a.routes), a.accessLog.middleware(a.routes), recovery())
 	r.Use(static.Serve("/css", static.LocalFile("public/css", true)))
 	if traceEnabled == "1" {
 		r.Use(graqt.RequestIdForGin())
 	}
 
-	layout := "templates/layout.tmpl"
+	// ISHOCON2_TEMPLATE_RELOAD=1 だと編集したテンプレートを再起動せずに反映する
+	templates, err := NewTemplates("templates", os.Getenv("ISHOCON2_TEMPLATE_RELOAD") == "1")
+	if err != nil {
+		panic(err.Error())
+	}
+	r.HTMLRender = templates
 
 	// session store
-	store := sessions.NewCookieStore([]byte("mysession"))
-	store.Options(sessions.Options{HttpOnly: true})
-	r.Use(sessions.Sessions("showwin_happy", store))
+	r.Use(sameSiteCookies(a.session.SameSite), sessions.Sessions(sessionName, a.session.store()))
 
 	// GET /
 	r.GET("/", func(c *gin.Context) {
-		electionResults := getElectionResult(c)
-
-		// 上位10人と最下位のみ表示
-		tmp := make([]CandidateElectionResult, len(electionResults))
-		copy(tmp, electionResults)
-		candidates := tmp[:10]
-		candidates = append(candidates, tmp[len(tmp)-1])
-
-		partyNames := getAllPartyName(c)
-		partyResultMap := map[string]int{}
-		for _, name := range partyNames {
-			partyResultMap[name] = 0
-		}
-		for _, r := range electionResults {
-			partyResultMap[r.PoliticalParty] += r.VoteCount
-		}
-		partyResults := []PartyElectionResult{}
-		for name, count := range partyResultMap {
-			r := PartyElectionResult{}
-			r.PoliticalParty = name
-			r.VoteCount = count
-			partyResults = append(partyResults, r)
-		}
-		// 投票数でソート
-		sort.Slice(partyResults, func(i, j int) bool { return partyResults[i].VoteCount > partyResults[j].VoteCount })
-
-		sexRatio := map[string]int{
-			"men":   0,
-			"women": 0,
-		}
-		for _, r := range electionResults {
-			if r.Sex == "男" {
-				sexRatio["men"] += r.VoteCount
-			} else if r.Sex == "女" {
-				sexRatio["women"] += r.VoteCount
-			}
-		}
+		result := a.getIndexResult(c)
 
-		funcs := template.FuncMap{"indexPlus1": func(i int) int { return i + 1 }}
-		r.SetHTMLTemplate(template.Must(template.New("main").Funcs(funcs).ParseFiles(layout, "templates/index.tmpl")))
-		c.HTML(http.StatusOK, "base", gin.H{
-			"candidates": candidates,
-			"parties":    partyResults,
-			"sexRatio":   sexRatio,
+		c.HTML(http.StatusOK, "index", gin.H{
+			"candidates": result.Candidates,
+			"parties":    result.Parties,
+			"sexRatio":   result.SexRatio,
 		})
 	})
 
 	// GET /candidates/:candidateID(int)
 	r.GET("/candidates/:candidateID", func(c *gin.Context) {
-		candidateID, _ := strconv.Atoi(c.Param("candidateID"))
-		candidate, err := getCandidate(c, candidateID)
+		candidateID, err := strconv.Atoi(c.Param("candidateID"))
 		if err != nil {
-			c.Redirect(http.StatusFound, "/")
+			c.String(http.StatusBadRequest, "400 bad request")
+			return
+		}
+		result, err := a.getCandidateResult(c, candidateID)
+		if err == errNotFound {
+			c.String(http.StatusNotFound, "404 page not found")
+			return
+		} else if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
 		}
-		votes := getVoteCountByCandidateID(c, candidateID)
-		candidateIDs := []int{candidateID}
-		keywords := getVoiceOfSupporter(c, candidateIDs)
 
-		r.SetHTMLTemplate(template.Must(template.ParseFiles(layout, "templates/candidate.tmpl")))
-		c.HTML(http.StatusOK, "base", gin.H{
-			"candidate": candidate,
-			"votes":     votes,
-			"keywords":  keywords,
+		c.HTML(http.StatusOK, "candidate", gin.H{
+			"candidate": result.Candidate,
+			"votes":     result.Votes,
+			"keywords":  result.Keywords,
 		})
 	})
 
 	// GET /political_parties/:name(string)
 	r.GET("/political_parties/:name", func(c *gin.Context) {
-		partyName := c.Param("name")
-		var votes int
-		electionResults := getElectionResult(c)
-		for _, r := range electionResults {
-			if r.PoliticalParty == partyName {
-				votes += r.VoteCount
-			}
+		result, err := a.getPartyResult(c, c.Param("name"))
+		if err == errNotFound {
+			c.String(http.StatusNotFound, "404 page not found")
+			return
+		} else if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
 		}
 
-		candidates := getCandidatesByPoliticalParty(c, partyName)
-		candidateIDs := []int{}
-		for _, c := range candidates {
-			candidateIDs = append(candidateIDs, c.ID)
-		}
-		keywords := getVoiceOfSupporter(c, candidateIDs)
-
-		r.SetHTMLTemplate(template.Must(template.ParseFiles(layout, "templates/political_party.tmpl")))
-		c.HTML(http.StatusOK, "base", gin.H{
-			"politicalParty": partyName,
-			"votes":          votes,
-			"candidates":     candidates,
-			"keywords":       keywords,
+		c.HTML(http.StatusOK, "political_party", gin.H{
+			"politicalParty": result.PoliticalParty,
+			"votes":          result.Votes,
+			"candidates":     result.Candidates,
+			"keywords":       result.Keywords,
 		})
 	})
 
 	// GET /vote
 	r.GET("/vote", func(c *gin.Context) {
-		candidates := getAllCandidate(c)
-
-		r.SetHTMLTemplate(template.Must(template.ParseFiles(layout, "templates/vote.tmpl")))
-		c.HTML(http.StatusOK, "base", gin.H{
-			"candidates": candidates,
-			"message":    "",
-		})
+		message, err := popFlash(c)
+		if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		a.renderVote(c, http.StatusOK, message)
 	})
 
 	// POST /vote
 	r.POST("/vote", func(c *gin.Context) {
-		user, userErr := getUser(c, c.PostForm("name"), c.PostForm("address"), c.PostForm("mynumber"))
-		candidate, cndErr := getCandidateByName(c, c.PostForm("candidate"))
-		votedCount := getUserVotedCount(c, user.ID)
-		candidates := getAllCandidate(c)
-		voteCount, _ := strconv.Atoi(c.PostForm("vote_count"))
+		if a.csrf && !validCSRFToken(c) {
+			c.String(http.StatusForbidden, "403 forbidden")
+			return
+		}
+		if message := a.limitVote(c); message != "" {
+			a.metrics.countVote(message, 0, false)
+			c.Set(voteMessageKey, message)
+			a.respondVote(c, http.StatusTooManyRequests, message)
+			return
+		}
+		// errNotFound 以外のエラーは入力の誤りではないので 500 にする
+		user, userErr := a.users.GetUser(c, c.PostForm("name"), c.PostForm("address"), c.PostForm("mynumber"))
+		if userErr != nil && userErr != errNotFound {
+			c.AbortWithError(http.StatusInternalServerError, userErr)
+			return
+		}
+		a.checkedIdentity(c, userErr)
+		candidate, cndErr := a.candidates.GetCandidateByName(c, c.PostForm("candidate"))
+		if cndErr != nil && cndErr != errNotFound {
+			c.AbortWithError(http.StatusInternalServerError, cndErr)
+			return
+		}
+		var votedCount int
+		if userErr == nil {
+			var err error
+			votedCount, err = a.votes.GetUserVotedCount(c, user.ID)
+			if err != nil {
+				c.AbortWithError(http.StatusInternalServerError, err)
+				return
+			}
+		}
+		voteCount, err := strconv.Atoi(c.PostForm("vote_count"))
+		if err != nil || voteCount < 0 {
+			message := "投票数を正しく記入してください"
+			a.metrics.countVote(message, 0, false)
+			c.Set(voteMessageKey, message)
+			a.respondVote(c, http.StatusBadRequest, message)
+			return
+		}
 
 		var message string
-		r.SetHTMLTemplate(template.Must(template.ParseFiles(layout, "templates/vote.tmpl")))
+		status := http.StatusOK
+		success := false
 		if userErr != nil {
 			message = "個人情報に誤りがあります"
 		} else if user.Votes < voteCount+votedCount {
@@ -185,23 +323,129 @@ func main() {
 			message = "候補者を正しく記入してください"
 		} else if c.PostForm("keyword") == "" {
 			message = "投票理由を記入してください"
+		} else if err := a.tally.Record(func() error {
+			return a.votes.CreateVotes(c, user.ID, candidate.ID, c.PostForm("keyword"), voteCount)
+		}, candidate.ID, c.PostForm("keyword"), voteCount); err == errVoteLimitExceeded {
+			message = "投票数が上限を超えています"
+		} else if err != nil {
+			c.Error(err)
+			status = http.StatusInternalServerError
+			message = "投票に失敗しました"
 		} else {
-			for i := 1; i <= voteCount; i++ {
-				createVote(c, user.ID, candidate.ID, c.PostForm("keyword"))
-			}
 			message = "投票に成功しました"
+			success = true
 		}
-		c.HTML(http.StatusOK, "base", gin.H{
-			"candidates": candidates,
-			"message":    message,
-		})
+		a.metrics.countVote(message, voteCount, success)
+		c.Set(voteMessageKey, message)
+		a.respondVote(c, status, message)
+	})
+
+	// JSON API
+	api := r.Group("/api/v1")
+
+	// GET /api/v1/results
+	api.GET("/results", func(c *gin.Context) {
+		c.JSON(http.StatusOK, a.getIndexResult(c))
+	})
+
+	// GET /api/v1/candidates/:candidateID(int)
+	api.GET("/candidates/:candidateID", func(c *gin.Context) {
+		candidateID, err := strconv.Atoi(c.Param("candidateID"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid candidate id"})
+			return
+		}
+		result, err := a.getCandidateResult(c, candidateID)
+		if err == errNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "candidate not found"})
+			return
+		} else if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		c.JSON(http.StatusOK, result)
+	})
+
+	// GET /api/v1/parties/:name(string)
+	api.GET("/parties/:name", func(c *gin.Context) {
+		result, err := a.getPartyResult(c, c.Param("name"))
+		if err == errNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "party not found"})
+			return
+		} else if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		c.JSON(http.StatusOK, result)
+	})
+
+	// GET /healthz プロセスが生きていれば 200
+	r.GET("/healthz", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	// GET /readyz DB に接続でき、テンプレートと集計の読み込みが終わっていれば 200
+	r.GET("/readyz", func(c *gin.Context) {
+		checks, ready := a.checkReady(c, templates)
+		if !ready {
+			c.JSON(http.StatusServiceUnavailable, checks)
+			return
+		}
+		c.JSON(http.StatusOK, checks)
 	})
 
 	r.GET("/initialize", func(c *gin.Context) {
-		db.Exec("DELETE FROM votes")
+		if err := a.votes.DeleteAllVotes(c); err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := a.reload(c); err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
 
 		c.String(http.StatusOK, "Finish")
 	})
 
-	r.Run(":8080")
+	a.routes.set(r.Routes())
+	return r
+}
+
+// renderVote renders the vote form with message
+func (a *App) renderVote(c *gin.Context, status int, message string) {
+	candidates, err := a.candidates.GetAllCandidate(c)
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	var token string
+	if a.csrf {
+		if token, err = csrfToken(c); err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	c.HTML(status, "vote", gin.H{
+		"candidates": candidates,
+		"message":    message,
+		"csrfToken":  token,
+	})
+}
+
+// respondVote returns the result of POST /vote.
+// With voteRedirect the message is passed to GET /vote as a flash, so that reloading the page does not vote again.
+func (a *App) respondVote(c *gin.Context, status int, message string) {
+	// リダイレクトするのは投票の処理まで進んだ 200 だけ。入力の誤り (400)、制限 (429)、500 は
+	// 投票できていないので再送信されても困らず、ステータスをクライアントとメトリクスに残すためそのまま返す
+	if !a.voteRedirect || status != http.StatusOK {
+		a.renderVote(c, status, message)
+		return
+	}
+	if err := addFlash(c, message); err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	c.Redirect(http.StatusSeeOther, "/vote")
 }
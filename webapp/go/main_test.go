@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+var (
+	testCandidates = []Candidate{
+		{ID: 1, Name: "佐藤 一郎", PoliticalParty: "夢実現党", Sex: "男"},
+		{ID: 2, Name: "佐藤 次郎", PoliticalParty: "夢実現党", Sex: "女"},
+		{ID: 3, Name: "鈴木 一郎", PoliticalParty: "国民10人大活躍党", Sex: "男"},
+	}
+	testUsers = []User{
+		{ID: 1, Name: "山田 太郎", Address: "東京都", MyNumber: "1000000001", Votes: 5},
+		{ID: 2, Name: "山田 花子", Address: "大阪府", MyNumber: "1000000002", Votes: 1},
+	}
+)
+
+// newUnloadedTestApp returns an App that does not print the access log and has not loaded the tally yet
+func newUnloadedTestApp(store *MemoryStore) *App {
+	app := NewApp(store, store, store)
+	app.accessLog = NewAccessLogger(ioutil.Discard)
+	return app
+}
+
+func newTestApp(t *testing.T, store *MemoryStore) *App {
+	app := newUnloadedTestApp(store)
+	if err := app.reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return app
+}
+
+func serve(app *App, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	app.Router().ServeHTTP(w, req)
+	return w
+}
+
+func postVote(app *App, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/vote", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return serve(app, req)
+}
+
+func voteForm(user User, candidate string, keyword string, voteCount string) url.Values {
+	return url.Values{
+		"name":       {user.Name},
+		"address":    {user.Address},
+		"mynumber":   {user.MyNumber},
+		"candidate":  {candidate},
+		"keyword":    {keyword},
+		"vote_count": {voteCount},
+	}
+}
+
+func TestGetRoutes(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	store.CreateVotes(context.Background(), 1, 3, "誠実さ", 2)
+
+	tests := []struct {
+		name     string
+		path     string
+		status   int
+		contains []string
+	}{
+		{"index", "/", http.StatusOK, []string{"鈴木 一郎", "夢実現党", "国民10人大活躍党"}},
+		{"candidate", "/candidates/3", http.StatusOK, []string{"鈴木 一郎", "国民10人大活躍党", "誠実さ"}},
+		{"unknown candidate", "/candidates/99", http.StatusNotFound, nil},
+		{"malformed candidate id", "/candidates/abc", http.StatusBadRequest, nil},
+		{"political party", "/political_parties/夢実現党", http.StatusOK, []string{"夢実現党", "佐藤 一郎", "佐藤 次郎"}},
+		{"another political party", "/political_parties/国民10人大活躍党", http.StatusOK, []string{"誠実さ"}},
+		{"unknown political party", "/political_parties/hoge", http.StatusNotFound, nil},
+		{"vote form", "/vote", http.StatusOK, []string{"投票フォーム", "佐藤 一郎", "佐藤 次郎", "鈴木 一郎"}},
+		{"api results", "/api/v1/results", http.StatusOK, []string{`"name":"鈴木 一郎"`}},
+		{"api candidate", "/api/v1/candidates/3", http.StatusOK, []string{`"votes":2`, "誠実さ"}},
+		{"api unknown candidate", "/api/v1/candidates/99", http.StatusNotFound, []string{"candidate not found"}},
+		{"api malformed candidate id", "/api/v1/candidates/abc", http.StatusBadRequest, []string{"invalid candidate id"}},
+		{"api party", "/api/v1/parties/国民10人大活躍党", http.StatusOK, []string{`"votes":2`}},
+		{"api unknown party", "/api/v1/parties/hoge", http.StatusNotFound, []string{"party not found"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(t, store)
+			w := serve(app, httptest.NewRequest("GET", tt.path, nil))
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(w.Body.String(), s) {
+					t.Errorf("body does not contain %q", s)
+				}
+			}
+		})
+	}
+}
+
+// failingVoteStore fails to write votes
+type failingVoteStore struct {
+	*MemoryStore
+}
+
+func (s failingVoteStore) CreateVotes(ctx context.Context, userID int, candidateID int, keyword string, voteCount int) error {
+	return errors.New("disk full")
+}
+
+func TestPostVote(t *testing.T) {
+	taro, hanako := testUsers[0], testUsers[1]
+	stranger := User{Name: "山田 太郎", Address: "東京都", MyNumber: "9999999999"}
+
+	tests := []struct {
+		name    string
+		form    url.Values
+		failing bool
+		status  int
+		message string
+	}{
+		{"success", voteForm(taro, "佐藤 一郎", "誠実さ", "3"), false, http.StatusOK, "投票に成功しました"},
+		{"unknown user", voteForm(stranger, "佐藤 一郎", "誠実さ", "1"), false, http.StatusOK, "個人情報に誤りがあります"},
+		{"too many votes", voteForm(hanako, "佐藤 一郎", "誠実さ", "2"), false, http.StatusOK, "投票数が上限を超えています"},
+		{"no candidate", voteForm(taro, "", "誠実さ", "1"), false, http.StatusOK, "候補者を記入してください"},
+		{"unknown candidate", voteForm(taro, "田中 一郎", "誠実さ", "1"), false, http.StatusOK, "候補者を正しく記入してください"},
+		{"no keyword", voteForm(taro, "佐藤 一郎", "", "1"), false, http.StatusOK, "投票理由を記入してください"},
+		{"store error", voteForm(taro, "佐藤 一郎", "誠実さ", "1"), true, http.StatusInternalServerError, "投票に失敗しました"},
+		{"malformed vote count", voteForm(taro, "佐藤 一郎", "誠実さ", "abc"), false, http.StatusBadRequest, "投票数を正しく記入してください"},
+		{"negative vote count", voteForm(taro, "佐藤 一郎", "誠実さ", "-1"), false, http.StatusBadRequest, "投票数を正しく記入してください"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewMemoryStore(testUsers, testCandidates)
+			app := newTestApp(t, store)
+			if tt.failing {
+				app.votes = failingVoteStore{store}
+			}
+
+			w := postVote(app, tt.form)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			if !strings.Contains(w.Body.String(), tt.message) {
+				t.Errorf("body does not contain %q", tt.message)
+			}
+		})
+	}
+}
+
+// brokenStore fails to read users or candidates, and counts the reads of the voted counts
+type brokenStore struct {
+	*MemoryStore
+	users, candidates bool
+	votedCountReads   int
+}
+
+func (s *brokenStore) GetUser(ctx context.Context, name string, address string, myNumber string) (User, error) {
+	if s.users {
+		return User{}, errors.New("connection refused")
+	}
+	return s.MemoryStore.GetUser(ctx, name, address, myNumber)
+}
+
+func (s *brokenStore) GetCandidateByName(ctx context.Context, name string) (Candidate, error) {
+	if s.candidates {
+		return Candidate{}, errors.New("connection refused")
+	}
+	return s.MemoryStore.GetCandidateByName(ctx, name)
+}
+
+func (s *brokenStore) GetUserVotedCount(ctx context.Context, userID int) (int, error) {
+	s.votedCountReads++
+	return s.MemoryStore.GetUserVotedCount(ctx, userID)
+}
+
+func TestPostVoteStoreErrors(t *testing.T) {
+	taro := testUsers[0]
+	stranger := User{Name: "山田 太郎", Address: "東京都", MyNumber: "9999999999"}
+	tests := []struct {
+		name              string
+		users, candidates bool
+		form              url.Values
+		status            int
+		votedCountReads   int
+	}{
+		{"users are down", true, false, voteForm(taro, "佐藤 一郎", "誠実さ", "1"), http.StatusInternalServerError, 0},
+		{"candidates are down", false, true, voteForm(taro, "佐藤 一郎", "誠実さ", "1"), http.StatusInternalServerError, 0},
+		{"unknown user", false, false, voteForm(stranger, "佐藤 一郎", "誠実さ", "1"), http.StatusOK, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mem := NewMemoryStore(testUsers, testCandidates)
+			store := &brokenStore{MemoryStore: mem, users: tt.users, candidates: tt.candidates}
+			app := newTestApp(t, mem)
+			app.users, app.candidates, app.votes = store, store, store
+
+			w := postVote(app, tt.form)
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+			if strings.Contains(w.Body.String(), "個人情報に誤りがあります") != (tt.status == http.StatusOK) {
+				t.Errorf("body = %q", w.Body.String())
+			}
+			if store.votedCountReads != tt.votedCountReads {
+				t.Errorf("voted count is read %d times, want %d", store.votedCountReads, tt.votedCountReads)
+			}
+		})
+	}
+}
+
+func TestPostVoteCountsPreviousVotes(t *testing.T) {
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+	taro := testUsers[0]
+
+	if w := postVote(app, voteForm(taro, "佐藤 一郎", "誠実さ", "4")); !strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Fatal("first vote failed")
+	}
+	if w := postVote(app, voteForm(taro, "佐藤 一郎", "誠実さ", "2")); !strings.Contains(w.Body.String(), "投票数が上限を超えています") {
+		t.Error("second vote was not limited")
+	}
+	if w := postVote(app, voteForm(taro, "佐藤 次郎", "経歴", "1")); !strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Error("vote within the limit failed")
+	}
+
+	w := serve(app, httptest.NewRequest("GET", "/api/v1/candidates/1", nil))
+	if !strings.Contains(w.Body.String(), `"votes":4`) {
+		t.Errorf("votes for 佐藤 一郎 are not counted: %s", w.Body.String())
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "5"))
+
+	w := serve(app, httptest.NewRequest("GET", "/initialize", nil))
+	if w.Code != http.StatusOK || w.Body.String() != "Finish" {
+		t.Fatalf("GET /initialize = %d %q", w.Code, w.Body.String())
+	}
+	if n, _ := store.GetUserVotedCount(context.Background(), 1); n != 0 {
+		t.Errorf("votes are left after /initialize: %d", n)
+	}
+	if n := app.tally.VoteCount(1); n != 0 {
+		t.Errorf("tally is not reset: %d", n)
+	}
+	if w := postVote(app, voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "5")); !strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Error("user cannot vote again after /initialize")
+	}
+}
+
+// panickingCandidateStore panics like the handlers used to on database errors
+type panickingCandidateStore struct {
+	*MemoryStore
+}
+
+func (s panickingCandidateStore) GetAllCandidate(ctx context.Context) ([]Candidate, error) {
+	panic("connection refused")
+}
+
+func TestRecovery(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	app.candidates = panickingCandidateStore{store}
+
+	w := serve(app, httptest.NewRequest("GET", "/vote", nil))
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", w.Code)
+	}
+	id := w.Header().Get("X-Request-Id")
+	if id == "" || !strings.Contains(w.Body.String(), id) {
+		t.Errorf("request id %q is not in the body %q", id, w.Body.String())
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Request-Id", "from-nginx")
+	if w := serve(app, req); w.Header().Get("X-Request-Id") != "from-nginx" {
+		t.Errorf("X-Request-Id = %q, want the id from the request", w.Header().Get("X-Request-Id"))
+	}
+}
+
+// unreachableStore fails to ping its database
+type unreachableStore struct {
+	*MemoryStore
+}
+
+func (s unreachableStore) Ping(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestReadyz(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+
+	if w := serve(newUnloadedTestApp(store), httptest.NewRequest("GET", "/healthz", nil)); w.Code != http.StatusOK {
+		t.Errorf("GET /healthz = %d before the tally is loaded", w.Code)
+	}
+
+	tests := []struct {
+		name     string
+		app      func() *App
+		status   int
+		contains string
+	}{
+		{"ready", func() *App { return newTestApp(t, store) }, http.StatusOK, `"tally":"ok"`},
+		{"tally is not loaded", func() *App { return newUnloadedTestApp(store) }, http.StatusServiceUnavailable, `"tally":"not loaded"`},
+		{"db is down", func() *App {
+			app := newTestApp(t, store)
+			app.votes = unreachableStore{store}
+			return app
+		}, http.StatusServiceUnavailable, `"db":"connection refused"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(tt.app(), httptest.NewRequest("GET", "/readyz", nil))
+			if w.Code != tt.status {
+				t.Errorf("status = %d, want %d", w.Code, tt.status)
+			}
+			if !strings.Contains(w.Body.String(), tt.contains) {
+				t.Errorf("body %q does not contain %q", w.Body.String(), tt.contains)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore implements UserStore, CandidateStore and VoteStore in memory.
+// It is meant for tests and for running the webapp without MySQL.
+// Each call is counted as one query in the QueryStats so that tests can find N+1 calls in handlers.
+type MemoryStore struct {
+	mu         sync.Mutex
+	users      []User
+	candidates []Candidate
+	votes      []Vote
+}
+
+// NewMemoryStore returns a store with the users and candidates and no votes
+func NewMemoryStore(users []User, candidates []Candidate) *MemoryStore {
+	s := &MemoryStore{}
+	s.users = append(s.users, users...)
+	s.candidates = append(s.candidates, candidates...)
+	return s
+}
+
+// GetUser implements UserStore
+func (s *MemoryStore) GetUser(ctx context.Context, name string, address string, myNumber string) (User, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if u.Name == name && u.Address == address && u.MyNumber == myNumber {
+			return u, nil
+		}
+	}
+	return User{}, errNotFound
+}
+
+// GetAllCandidate implements CandidateStore
+func (s *MemoryStore) GetAllCandidate(ctx context.Context) ([]Candidate, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Candidate{}, s.candidates...), nil
+}
+
+// GetCandidate implements CandidateStore
+func (s *MemoryStore) GetCandidate(ctx context.Context, candidateID int) (Candidate, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.candidates {
+		if c.ID == candidateID {
+			return c, nil
+		}
+	}
+	return Candidate{}, errNotFound
+}
+
+// GetCandidateByName implements CandidateStore
+func (s *MemoryStore) GetCandidateByName(ctx context.Context, name string) (Candidate, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.candidates {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return Candidate{}, errNotFound
+}
+
+// GetCandidatesByPoliticalParty implements CandidateStore
+func (s *MemoryStore) GetCandidatesByPoliticalParty(ctx context.Context, party string) ([]Candidate, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	candidates := []Candidate{}
+	for _, c := range s.candidates {
+		if c.PoliticalParty == party {
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates, nil
+}
+
+// GetUserVotedCount implements VoteStore
+func (s *MemoryStore) GetUserVotedCount(ctx context.Context, userID int) (int, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.votedCount(userID), nil
+}
+
+func (s *MemoryStore) votedCount(userID int) (count int) {
+	for _, v := range s.votes {
+		if v.UserID == userID {
+			count += v.Count
+		}
+	}
+	return
+}
+
+// CreateVotes implements VoteStore
+func (s *MemoryStore) CreateVotes(ctx context.Context, userID int, candidateID int, keyword string, voteCount int) error {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maxVotes := -1
+	for _, u := range s.users {
+		if u.ID == userID {
+			maxVotes = u.Votes
+			break
+		}
+	}
+	if maxVotes < 0 {
+		return errNotFound
+	}
+	if maxVotes < s.votedCount(userID)+voteCount {
+		return errVoteLimitExceeded
+	}
+
+	if voteCount > 0 {
+		s.votes = append(s.votes, Vote{
+			ID:          len(s.votes) + 1,
+			UserID:      userID,
+			CandidateID: candidateID,
+			Keyword:     keyword,
+			Count:       voteCount,
+		})
+	}
+	return nil
+}
+
+// VoteCounts implements VoteStore
+func (s *MemoryStore) VoteCounts(ctx context.Context) ([]VoteCount, error) {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type key struct {
+		candidateID int
+		keyword     string
+	}
+	sums := map[key]int{}
+	for _, v := range s.votes {
+		sums[key{v.CandidateID, v.Keyword}] += v.Count
+	}
+	counts := make([]VoteCount, 0, len(sums))
+	for k, n := range sums {
+		counts = append(counts, VoteCount{CandidateID: k.candidateID, Keyword: k.keyword, Count: n})
+	}
+	return counts, nil
+}
+
+// DeleteAllVotes implements VoteStore
+func (s *MemoryStore) DeleteAllVotes(ctx context.Context) error {
+	defer observeQuery(ctx, time.Now())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.votes = nil
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 秒単位のレイテンシのバケット
+var latencyBuckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Metrics counts requests and votes for /metrics in the Prometheus text format
+type Metrics struct {
+	mu           sync.Mutex
+	requests     map[requestKey]int
+	latencies    map[routeKey]*latencyHistogram
+	votesCast    int
+	voteFailures map[string]int
+}
+
+type routeKey struct {
+	method string
+	route  string
+}
+
+type requestKey struct {
+	routeKey
+	status int
+}
+
+type latencyHistogram struct {
+	counts []int // latencyBuckets ごとの数。累積ではない
+	sum    float64
+	count  int
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{
+		requests:     map[requestKey]int{},
+		latencies:    map[routeKey]*latencyHistogram{},
+		voteFailures: map[string]int{},
+	}
+}
+
+func (m *Metrics) middleware(routes *routeTable) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		elapsed := time.Since(start)
+		route := routes.of(c)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		key := routeKey{method: c.Request.Method, route: route}
+		m.requests[requestKey{key, c.Writer.Status()}]++
+		h := m.latencies[key]
+		if h == nil {
+			h = &latencyHistogram{counts: make([]int, len(latencyBuckets))}
+			m.latencies[key] = h
+		}
+		h.observe(elapsed.Seconds())
+	}
+}
+
+func (h *latencyHistogram) observe(v float64) {
+	for i, le := range latencyBuckets {
+		if v <= le {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// countVote counts the result of POST /vote by its message
+func (m *Metrics) countVote(message string, voteCount int, success bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if success {
+		m.votesCast += voteCount
+	} else {
+		m.voteFailures[message]++
+	}
+}
+
+// Handler serves the metrics and the pool stats of the stores
+func (m *Metrics) Handler(stores ...interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		m.write(w)
+		for _, s := range stores {
+			if s, ok := s.(DBStatser); ok {
+				writeDBStats(w, s.DBStats())
+				break
+			}
+		}
+	})
+}
+
+func (m *Metrics) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP ishocon2_http_requests_total Number of HTTP requests.")
+	fmt.Fprintln(w, "# TYPE ishocon2_http_requests_total counter")
+	requestKeys := make([]requestKey, 0, len(m.requests))
+	for k := range m.requests {
+		requestKeys = append(requestKeys, k)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		if requestKeys[i].routeKey != requestKeys[j].routeKey {
+			return requestKeys[i].routeKey.less(requestKeys[j].routeKey)
+		}
+		return requestKeys[i].status < requestKeys[j].status
+	})
+	for _, k := range requestKeys {
+		fmt.Fprintf(w, "ishocon2_http_requests_total{method=%s,route=%s,status=\"%d\"} %d\n",
+			quoteLabel(k.method), quoteLabel(k.route), k.status, m.requests[k])
+	}
+
+	fmt.Fprintln(w, "# HELP ishocon2_http_request_duration_seconds Latency of HTTP requests.")
+	fmt.Fprintln(w, "# TYPE ishocon2_http_request_duration_seconds histogram")
+	routeKeys := make([]routeKey, 0, len(m.latencies))
+	for k := range m.latencies {
+		routeKeys = append(routeKeys, k)
+	}
+	sort.Slice(routeKeys, func(i, j int) bool { return routeKeys[i].less(routeKeys[j]) })
+	for _, k := range routeKeys {
+		h := m.latencies[k]
+		labels := "method=" + quoteLabel(k.method) + ",route=" + quoteLabel(k.route)
+		cumulative := 0
+		for i, le := range latencyBuckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(w, "ishocon2_http_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n",
+				labels, strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(w, "ishocon2_http_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(w, "ishocon2_http_request_duration_seconds_sum{%s} %g\n", labels, h.sum)
+		fmt.Fprintf(w, "ishocon2_http_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	fmt.Fprintln(w, "# HELP ishocon2_votes_cast_total Number of votes accepted by POST /vote.")
+	fmt.Fprintln(w, "# TYPE ishocon2_votes_cast_total counter")
+	fmt.Fprintf(w, "ishocon2_votes_cast_total %d\n", m.votesCast)
+
+	fmt.Fprintln(w, "# HELP ishocon2_vote_failures_total Number of rejected POST /vote by message.")
+	fmt.Fprintln(w, "# TYPE ishocon2_vote_failures_total counter")
+	messages := make([]string, 0, len(m.voteFailures))
+	for message := range m.voteFailures {
+		messages = append(messages, message)
+	}
+	sort.Strings(messages)
+	for _, message := range messages {
+		fmt.Fprintf(w, "ishocon2_vote_failures_total{message=%s} %d\n", quoteLabel(message), m.voteFailures[message])
+	}
+}
+
+func writeDBStats(w io.Writer, s sql.DBStats) {
+	gauges := []struct {
+		name, help string
+		value      int
+	}{
+		{"ishocon2_db_max_open_connections", "Maximum number of open connections to the database.", s.MaxOpenConnections},
+		{"ishocon2_db_open_connections", "Number of open connections to the database.", s.OpenConnections},
+		{"ishocon2_db_in_use_connections", "Number of connections in use.", s.InUse},
+		{"ishocon2_db_idle_connections", "Number of idle connections.", s.Idle},
+	}
+	for _, g := range gauges {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %d\n", g.name, g.help, g.name, g.name, g.value)
+	}
+	fmt.Fprintln(w, "# HELP ishocon2_db_wait_count_total Number of connections waited for.")
+	fmt.Fprintln(w, "# TYPE ishocon2_db_wait_count_total counter")
+	fmt.Fprintf(w, "ishocon2_db_wait_count_total %d\n", s.WaitCount)
+	fmt.Fprintln(w, "# HELP ishocon2_db_wait_duration_seconds_total Time blocked waiting for a new connection.")
+	fmt.Fprintln(w, "# TYPE ishocon2_db_wait_duration_seconds_total counter")
+	fmt.Fprintf(w, "ishocon2_db_wait_duration_seconds_total %g\n", s.WaitDuration.Seconds())
+}
+
+func (k routeKey) less(o routeKey) bool {
+	if k.route != o.route {
+		return k.route < o.route
+	}
+	return k.method < o.method
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quoteLabel(v string) string {
+	return `"` + labelReplacer.Replace(v) + `"`
+}
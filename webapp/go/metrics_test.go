@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	store := NewMemoryStore(testUsers, testCandidates)
+	app := newTestApp(t, store)
+	taro := testUsers[0]
+
+	serve(app, httptest.NewRequest("GET", "/candidates/1", nil))
+	serve(app, httptest.NewRequest("GET", "/candidates/2", nil))
+	serve(app, httptest.NewRequest("GET", "/candidates/99", nil))
+	postVote(app, voteForm(taro, "佐藤 一郎", "誠実さ", "3"))
+	postVote(app, voteForm(taro, "佐藤 一郎", "", "1"))
+	postVote(app, voteForm(taro, "佐藤 一郎", "誠実さ", "5"))
+
+	if w := serve(app, httptest.NewRequest("GET", "/metrics", nil)); w.Code != http.StatusNotFound {
+		t.Errorf("GET /metrics on the webapp = %d, want 404", w.Code)
+	}
+
+	w := httptest.NewRecorder()
+	app.metrics.Handler(store).ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	for _, line := range []string{
+		`ishocon2_http_requests_total{method="GET",route="/candidates/:candidateID",status="200"} 2`,
+		`ishocon2_http_requests_total{method="GET",route="/candidates/:candidateID",status="404"} 1`,
+		`ishocon2_http_requests_total{method="POST",route="/vote",status="200"} 3`,
+		`ishocon2_http_requests_total{method="GET",route="other",status="404"} 1`,
+		`ishocon2_http_request_duration_seconds_count{method="POST",route="/vote"} 3`,
+		`ishocon2_http_request_duration_seconds_bucket{method="POST",route="/vote",le="+Inf"} 3`,
+		`ishocon2_votes_cast_total 3`,
+		`ishocon2_vote_failures_total{message="投票理由を記入してください"} 1`,
+		`ishocon2_vote_failures_total{message="投票数が上限を超えています"} 1`,
+	} {
+		if !strings.Contains(w.Body.String(), line+"\n") {
+			t.Errorf("metrics do not have %s\n%s", line, w.Body.String())
+		}
+	}
+}
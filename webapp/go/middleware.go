@@ -0,0 +1,93 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+	requestIDKey    = "requestID"
+)
+
+// requestID gives each request an id and returns it in the X-Request-Id header.
+// An id set by nginx in the request header is used as is.
+func requestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(requestIDHeader, id)
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func getRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// recovery turns a panic in a handler into 500 and logs it with the request id.
+// Errors attached to other 500 responses by c.AbortWithError or c.Error are logged too.
+func recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Printf("[%s] %s %s: panic: %v\n%s", getRequestID(c), c.Request.Method, c.Request.URL.Path, err, debug.Stack())
+				c.String(http.StatusInternalServerError, "500 internal server error (request id: %s)", getRequestID(c))
+				c.Abort()
+			}
+		}()
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			for _, e := range c.Errors {
+				log.Printf("[%s] %s %s: %s", getRequestID(c), c.Request.Method, c.Request.URL.Path, e.Err)
+			}
+		}
+	}
+}
+
+// routeTable finds the route pattern such as /candidates/:candidateID of a request,
+// so that logs and metrics are grouped by route rather than by path.
+type routeTable struct {
+	mu        sync.RWMutex
+	byHandler map[string]string
+}
+
+func (t *routeTable) set(routes gin.RoutesInfo) {
+	byHandler := map[string]string{}
+	for _, r := range routes {
+		byHandler[r.Method+" "+r.Handler] = r.Path
+	}
+	t.mu.Lock()
+	t.byHandler = byHandler
+	t.mu.Unlock()
+}
+
+func (t *routeTable) of(c *gin.Context) string {
+	t.mu.RLock()
+	route, ok := t.byHandler[c.Request.Method+" "+c.HandlerName()]
+	t.mu.RUnlock()
+	if ok {
+		return route
+	}
+	if strings.HasPrefix(c.Request.URL.Path, "/css/") {
+		return "/css"
+	}
+	return "other"
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migration is a pair of <version>_<name>.up.sql and <version>_<name>.down.sql in the migrations directory
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+const migrateUsage = "usage: migrate [--dir DIR] up|down|status"
+
+// defaultMigrationsDir は実行ファイルの隣の migrations を返す。無ければ作業ディレクトリの migrations
+func defaultMigrationsDir() string {
+	if exe, err := os.Executable(); err == nil {
+		dir := filepath.Join(filepath.Dir(exe), "migrations")
+		if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+			return dir
+		}
+	}
+	return "migrations"
+}
+
+// runMigrate は `migrate up|down|status` サブコマンドを実行する
+func runMigrate(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(w)
+	dir := fs.String("dir", defaultMigrationsDir(), "directory of the migration files")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New(migrateUsage)
+	}
+	migrations, err := loadMigrations(*dir)
+	if err != nil {
+		return err
+	}
+	if err := ensureMigrationTable(); err != nil {
+		return err
+	}
+
+	switch fs.Arg(0) {
+	case "up":
+		return migrateUp(migrations, w)
+	case "down":
+		return migrateDown(migrations, w)
+	case "status":
+		return migrateStatus(migrations, w)
+	}
+	return errors.New(migrateUsage)
+}
+
+func loadMigrations(dir string) ([]Migration, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int]*Migration{}
+	for _, f := range files {
+		m := migrationFileRegexp.FindStringSubmatch(f.Name())
+		if m == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(m[1])
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s, %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(b)
+		} else {
+			mig.Down = string(b)
+		}
+	}
+
+	migrations := []Migration{}
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up.sql", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func ensureMigrationTable() error {
+	_, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version int NOT NULL,
+			name varchar(255) NOT NULL,
+			applied_at datetime NOT NULL,
+			PRIMARY KEY (version)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`)
+	if err != nil {
+		return err
+	}
+	// DDL はトランザクションで戻せないので、途中で失敗したマイグレーションは実行済みの文の数を残して続きから再開する
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migration_progress (
+			version int NOT NULL,
+			direction varchar(4) NOT NULL,
+			statements int NOT NULL,
+			PRIMARY KEY (version, direction)
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`)
+	return err
+}
+
+func appliedMigrations() (map[int]time.Time, error) {
+	rows, err := db.Query("SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt []byte
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		t, _ := time.Parse("2006-01-02 15:04:05", string(appliedAt))
+		applied[version] = t
+	}
+	return applied, rows.Err()
+}
+
+func migrateUp(migrations []Migration, w io.Writer) error {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		fmt.Fprintf(w, "up: %d_%s\n", m.Version, m.Name)
+		if err := execMigration(m, "up", m.Up, w); err != nil {
+			return err
+		}
+		err := finishMigration(m, "up", "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, NOW())", m.Version, m.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 適用済みのうち最新の1つを戻す
+func migrateDown(migrations []Migration, w io.Writer) error {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+		if m.Down == "" {
+			return fmt.Errorf("%d_%s has no down.sql", m.Version, m.Name)
+		}
+		fmt.Fprintf(w, "down: %d_%s\n", m.Version, m.Name)
+		if err := execMigration(m, "down", m.Down, w); err != nil {
+			return err
+		}
+		return finishMigration(m, "down", "DELETE FROM schema_migrations WHERE version = ?", m.Version)
+	}
+	fmt.Fprintln(w, "no migration to roll back")
+	return nil
+}
+
+func migrateStatus(migrations []Migration, w io.Writer) error {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if t, ok := applied[m.Version]; ok {
+			fmt.Fprintf(w, "applied %s  %d_%s\n", t.Format("2006-01-02 15:04:05"), m.Version, m.Name)
+		} else {
+			fmt.Fprintf(w, "pending                      %d_%s\n", m.Version, m.Name)
+		}
+	}
+	return nil
+}
+
+// execMigration は src の文を順に実行し、1文ごとに schema_migration_progress に記録する。
+// 前回途中で失敗していれば、実行済みの文を飛ばして続きから実行する
+func execMigration(m Migration, direction string, src string, w io.Writer) error {
+	var done int
+	err := db.QueryRow("SELECT statements FROM schema_migration_progress WHERE version = ? AND direction = ?", m.Version, direction).Scan(&done)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	stmts := splitStatements(src)
+	if done > 0 {
+		fmt.Fprintf(w, "  resuming after %d of %d statements\n", done, len(stmts))
+	}
+	for i := done; i < len(stmts); i++ {
+		if _, err := db.Exec(stmts[i]); err != nil {
+			return fmt.Errorf("%d_%s.%s.sql statement %d: %s", m.Version, m.Name, direction, i+1, err)
+		}
+		_, err := db.Exec("REPLACE INTO schema_migration_progress (version, direction, statements) VALUES (?, ?, ?)", m.Version, direction, i+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishMigration は schema_migrations の更新と途中経過の削除を1つのトランザクションで行う
+func finishMigration(m Migration, direction string, query string, args ...interface{}) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(query, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM schema_migration_progress WHERE version = ? AND direction = ?", m.Version, direction); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// splitStatements は SQL ファイルを文に分ける。文は行末の ; で終わること。-- で始まる行は読み飛ばす
+func splitStatements(src string) []string {
+	var stmts, stmt []string
+	for _, line := range strings.Split(src, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		stmt = append(stmt, line)
+		if strings.HasSuffix(strings.TrimSpace(line), ";") {
+			stmts = append(stmts, strings.TrimSpace(strings.Join(stmt, "\n")))
+			stmt = nil
+		}
+	}
+	if rest := strings.TrimSpace(strings.Join(stmt, "\n")); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
+
+func migrateMain(args []string) {
+	if err := runMigrate(args, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
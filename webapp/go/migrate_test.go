@@ -0,0 +1,32 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	src := "-- comment\nALTER TABLE votes\n  ADD COLUMN n int;\n\nDROP TABLE a;\nSELECT 1"
+	want := []string{"ALTER TABLE votes\n  ADD COLUMN n int;", "DROP TABLE a;", "SELECT 1"}
+	if got := splitStatements(src); !reflect.DeepEqual(got, want) {
+		t.Errorf("splitStatements = %q, want %q", got, want)
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations("migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("migration %d has version %d", i, m.Version)
+		}
+		if m.Down == "" {
+			t.Errorf("%d_%s has no down.sql", m.Version, m.Name)
+		}
+		if len(splitStatements(m.Up)) == 0 {
+			t.Errorf("%d_%s has no statement", m.Version, m.Name)
+		}
+	}
+}
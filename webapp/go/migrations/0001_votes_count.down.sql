@@ -0,0 +1,15 @@
+-- count 列の票数だけ行を複製して1票1行に戻す (count は 1000 未満であること)
+CREATE TABLE votes_old LIKE votes;
+ALTER TABLE votes_old DROP COLUMN `count`;
+INSERT INTO votes_old (user_id, candidate_id, keyword)
+  SELECT v.user_id, v.candidate_id, v.keyword
+  FROM votes AS v
+  JOIN (
+    SELECT a.n + b.n * 10 + c.n * 100 AS n
+    FROM (SELECT 0 AS n UNION ALL SELECT 1 UNION ALL SELECT 2 UNION ALL SELECT 3 UNION ALL SELECT 4 UNION ALL SELECT 5 UNION ALL SELECT 6 UNION ALL SELECT 7 UNION ALL SELECT 8 UNION ALL SELECT 9) AS a
+    CROSS JOIN (SELECT 0 AS n UNION ALL SELECT 1 UNION ALL SELECT 2 UNION ALL SELECT 3 UNION ALL SELECT 4 UNION ALL SELECT 5 UNION ALL SELECT 6 UNION ALL SELECT 7 UNION ALL SELECT 8 UNION ALL SELECT 9) AS b
+    CROSS JOIN (SELECT 0 AS n UNION ALL SELECT 1 UNION ALL SELECT 2 UNION ALL SELECT 3 UNION ALL SELECT 4 UNION ALL SELECT 5 UNION ALL SELECT 6 UNION ALL SELECT 7 UNION ALL SELECT 8 UNION ALL SELECT 9) AS c
+  ) AS seq
+  ON seq.n < v.`count`;
+RENAME TABLE votes TO votes_new, votes_old TO votes;
+DROP TABLE votes_new;
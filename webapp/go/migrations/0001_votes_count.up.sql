@@ -0,0 +1,11 @@
+-- votes を1票1行から1投票1行 (count 列に票数) に変換する
+-- count 列のデフォルトは 1 なので、1票1行で書き込む実装もそのまま動く
+ALTER TABLE votes ADD COLUMN `count` int(4) NOT NULL DEFAULT 1;
+
+CREATE TABLE votes_new LIKE votes;
+INSERT INTO votes_new (user_id, candidate_id, keyword, `count`)
+  SELECT user_id, candidate_id, keyword, SUM(`count`)
+  FROM votes
+  GROUP BY user_id, candidate_id, keyword;
+RENAME TABLE votes TO votes_old, votes_new TO votes;
+DROP TABLE votes_old;
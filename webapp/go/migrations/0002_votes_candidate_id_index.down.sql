@@ -0,0 +1 @@
+ALTER TABLE votes DROP INDEX `candidate_id`;
@@ -0,0 +1 @@
+ALTER TABLE votes ADD INDEX `candidate_id` (`candidate_id`);
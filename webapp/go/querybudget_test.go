@@ -0,0 +1,75 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// assertQueryBudget fails the test if the request makes more than budget queries.
+// With the MemoryStore a query is a call to the store.
+func assertQueryBudget(t *testing.T, app *App, req *http.Request, budget int) {
+	t.Helper()
+	app.debugHeaders = true
+	w := serve(app, req)
+	n, err := strconv.Atoi(w.Header().Get("X-Query-Count"))
+	if err != nil {
+		t.Fatalf("%s %s: no X-Query-Count header (status %d)", req.Method, req.URL.Path, w.Code)
+	}
+	if n > budget {
+		t.Errorf("%s %s: %d queries, budget is %d", req.Method, req.URL.Path, n, budget)
+	}
+}
+
+func voteRequest() *http.Request {
+	form := voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "3")
+	req := httptest.NewRequest("POST", "/vote", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestQueryBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    func() *http.Request
+		budget int
+	}{
+		// 集計はメモリ上の tally から返すので DB は引かない
+		{"index", func() *http.Request { return httptest.NewRequest("GET", "/", nil) }, 0},
+		{"candidate", func() *http.Request { return httptest.NewRequest("GET", "/candidates/1", nil) }, 1},
+		{"political party", func() *http.Request { return httptest.NewRequest("GET", "/political_parties/夢実現党", nil) }, 1},
+		{"vote form", func() *http.Request { return httptest.NewRequest("GET", "/vote", nil) }, 1},
+		{"api results", func() *http.Request { return httptest.NewRequest("GET", "/api/v1/results", nil) }, 0},
+		{"api candidate", func() *http.Request { return httptest.NewRequest("GET", "/api/v1/candidates/1", nil) }, 1},
+		{"api party", func() *http.Request { return httptest.NewRequest("GET", "/api/v1/parties/夢実現党", nil) }, 1},
+		// ユーザ、候補者、投票済みの数、候補者一覧、投票の書き込み
+		{"vote", voteRequest, 5},
+		{"initialize", func() *http.Request { return httptest.NewRequest("GET", "/initialize", nil) }, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+			assertQueryBudget(t, app, tt.req(), tt.budget)
+		})
+	}
+}
+
+func TestQueryHeaders(t *testing.T) {
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+
+	w := serve(app, httptest.NewRequest("GET", "/vote", nil))
+	if h := w.Header().Get("X-Query-Count"); h != "" {
+		t.Errorf("X-Query-Count = %q without debug headers", h)
+	}
+
+	app.debugHeaders = true
+	w = serve(app, httptest.NewRequest("GET", "/vote", nil))
+	if h := w.Header().Get("X-Query-Count"); h != "1" {
+		t.Errorf("X-Query-Count = %q, want 1", h)
+	}
+	if h := w.Header().Get("X-DB-Time"); h == "" {
+		t.Error("no X-DB-Time header")
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gin.Context.Value は文字列のキーしか見ないので、キーは文字列にする
+const queryStatsKey = "queryStats"
+
+// QueryStats counts the SQL queries of a request and the time spent in them
+type QueryStats struct {
+	queries int64
+	dbTime  int64
+}
+
+// Queries returns the number of queries so far
+func (s *QueryStats) Queries() int {
+	return int(atomic.LoadInt64(&s.queries))
+}
+
+// DBTime returns the time spent in queries so far. For QueryContext it does not include reading the rows.
+func (s *QueryStats) DBTime() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.dbTime))
+}
+
+func queryStatsFrom(ctx context.Context) *QueryStats {
+	s, _ := ctx.Value(queryStatsKey).(*QueryStats)
+	return s
+}
+
+// observeQuery counts a query started at start. Call it with defer.
+func observeQuery(ctx context.Context, start time.Time) {
+	if s := queryStatsFrom(ctx); s != nil {
+		atomic.AddInt64(&s.queries, 1)
+		atomic.AddInt64(&s.dbTime, int64(time.Since(start)))
+	}
+}
+
+// queryDB is a *sql.DB that counts queries and their time in the QueryStats of the context
+type queryDB struct {
+	*sql.DB
+}
+
+func (db *queryDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observeQuery(ctx, time.Now())
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+func (db *queryDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer observeQuery(ctx, time.Now())
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+func (db *queryDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observeQuery(ctx, time.Now())
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+func (db *queryDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*queryTx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &queryTx{tx}, nil
+}
+
+// queryTx is a *sql.Tx that counts queries like queryDB
+type queryTx struct {
+	*sql.Tx
+}
+
+func (tx *queryTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observeQuery(ctx, time.Now())
+	return tx.Tx.QueryContext(ctx, query, args...)
+}
+
+func (tx *queryTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer observeQuery(ctx, time.Now())
+	return tx.Tx.QueryRowContext(ctx, query, args...)
+}
+
+func (tx *queryTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observeQuery(ctx, time.Now())
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// countQueries sets a QueryStats to each request.
+// With debugHeaders, the stats are returned in the X-Query-Count and X-DB-Time headers.
+func countQueries(debugHeaders bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		stats := &QueryStats{}
+		c.Set(queryStatsKey, stats)
+		if !debugHeaders {
+			c.Next()
+			return
+		}
+		w := &queryHeaderWriter{ResponseWriter: c.Writer, stats: stats}
+		c.Writer = w
+		c.Next()
+		// 本文の無いレスポンス (リダイレクトなど) は gin が最後にヘッダを書く
+		w.setHeaders()
+	}
+}
+
+// queryHeaderWriter adds the headers just before the response is written,
+// so they have the queries done before rendering.
+type queryHeaderWriter struct {
+	gin.ResponseWriter
+	stats *QueryStats
+}
+
+func (w *queryHeaderWriter) setHeaders() {
+	if w.Written() {
+		return
+	}
+	w.Header().Set("X-Query-Count", strconv.Itoa(w.stats.Queries()))
+	w.Header().Set("X-DB-Time", w.stats.DBTime().String())
+}
+
+func (w *queryHeaderWriter) WriteHeaderNow() {
+	w.setHeaders()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *queryHeaderWriter) Write(b []byte) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *queryHeaderWriter) WriteString(s string) (int, error) {
+	w.setHeaders()
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	errRateLimited = errors.New("rate limited")
+	errLockedOut   = errors.New("locked out")
+)
+
+// LimiterStore keeps the token buckets and the failure counts of the RateLimiter.
+// MemoryLimiterStore keeps them in the process. To limit across several webapp processes,
+// implement it on a shared store such as Redis.
+type LimiterStore interface {
+	// Take takes a token from the bucket of key, which holds up to burst tokens and gets rate tokens per second.
+	// It returns false if the bucket is empty.
+	Take(ctx context.Context, key string, rate float64, burst int) (bool, error)
+	// AddFailure counts a failure of key and returns the count. The count expires ttl after the first failure.
+	AddFailure(ctx context.Context, key string, ttl time.Duration) (int, error)
+	// Failures returns the count of key
+	Failures(ctx context.Context, key string) (int, error)
+	// ResetFailures clears the count of key
+	ResetFailures(ctx context.Context, key string) error
+}
+
+// RateLimitConfig is the thresholds of the RateLimiter. A zero rate or MaxFailures disables the limit.
+type RateLimitConfig struct {
+	// POST /vote per second and burst per client IP
+	IPRate  float64
+	IPBurst int
+	// POST /vote per second and burst per mynumber
+	MyNumberRate  float64
+	MyNumberBurst int
+	// failed identity checks of an IP or a mynumber before it is locked out for Lockout
+	MaxFailures int
+	Lockout     time.Duration
+	// X-Real-IP is the client IP only in requests from these proxies (nginx).
+	// Other requests use the address of the connection.
+	TrustedProxies []*net.IPNet
+}
+
+// parseTrustedProxies parses IPs and CIDRs separated by commas
+func parseTrustedProxies(s string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip == nil {
+				return nil, fmt.Errorf("invalid proxy address: %q", p)
+			} else if ip.To4() != nil {
+				p += "/32"
+			} else {
+				p += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, n)
+	}
+	return proxies, nil
+}
+
+// Enabled reports whether any limit is set
+func (cfg RateLimitConfig) Enabled() bool {
+	return cfg.IPRate > 0 || cfg.MyNumberRate > 0 || cfg.MaxFailures > 0
+}
+
+// RateLimiter limits POST /vote per client IP and per mynumber,
+// and locks them out after failed identity checks so that personal data cannot be found by brute force.
+type RateLimiter struct {
+	cfg   RateLimitConfig
+	store LimiterStore
+}
+
+// NewRateLimiter returns a limiter keeping its state in store
+func NewRateLimiter(cfg RateLimitConfig, store LimiterStore) *RateLimiter {
+	if cfg.IPBurst < 1 {
+		cfg.IPBurst = 1
+	}
+	if cfg.MyNumberBurst < 1 {
+		cfg.MyNumberBurst = 1
+	}
+	return &RateLimiter{cfg: cfg, store: store}
+}
+
+// clientIP returns the IP of the client of r. X-Real-IP set by nginx is used only when the connection
+// comes from a trusted proxy or a unix socket, and X-Forwarded-For is never read, so a client cannot choose its bucket.
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	// unix ソケットには同じホストの nginx しか繋げない
+	trusted := ip == nil
+	for _, n := range l.cfg.TrustedProxies {
+		if ip != nil && n.Contains(ip) {
+			trusted = true
+			break
+		}
+	}
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); trusted && realIP != "" {
+		return realIP
+	}
+	return host
+}
+
+// allow returns errLockedOut or errRateLimited if the vote must be refused
+func (l *RateLimiter) allow(ctx context.Context, ip string, myNumber string) error {
+	if l.cfg.MaxFailures > 0 {
+		for _, key := range []string{"ip:" + ip, "mynumber:" + myNumber} {
+			n, err := l.store.Failures(ctx, key)
+			if err != nil {
+				return err
+			}
+			if n >= l.cfg.MaxFailures {
+				return errLockedOut
+			}
+		}
+	}
+	if l.cfg.IPRate > 0 {
+		if ok, err := l.store.Take(ctx, "ip:"+ip, l.cfg.IPRate, l.cfg.IPBurst); err != nil {
+			return err
+		} else if !ok {
+			return errRateLimited
+		}
+	}
+	if l.cfg.MyNumberRate > 0 {
+		if ok, err := l.store.Take(ctx, "mynumber:"+myNumber, l.cfg.MyNumberRate, l.cfg.MyNumberBurst); err != nil {
+			return err
+		} else if !ok {
+			return errRateLimited
+		}
+	}
+	return nil
+}
+
+// failed counts a failed identity check of ip and myNumber
+func (l *RateLimiter) failed(ctx context.Context, ip string, myNumber string) error {
+	if l.cfg.MaxFailures <= 0 {
+		return nil
+	}
+	for _, key := range []string{"ip:" + ip, "mynumber:" + myNumber} {
+		if _, err := l.store.AddFailure(ctx, key, l.cfg.Lockout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// succeeded clears the failures of myNumber. The failures of the IP are kept,
+// otherwise a valid identity between the guesses would reset them.
+func (l *RateLimiter) succeeded(ctx context.Context, myNumber string) error {
+	if l.cfg.MaxFailures <= 0 {
+		return nil
+	}
+	return l.store.ResetFailures(ctx, "mynumber:"+myNumber)
+}
+
+// limitVote returns the message to refuse POST /vote, or "" to accept it.
+// Errors of the LimiterStore are logged and the vote is accepted.
+func (a *App) limitVote(c *gin.Context) string {
+	if a.limiter == nil {
+		return ""
+	}
+	switch err := a.limiter.allow(c, a.limiter.clientIP(c.Request), c.PostForm("mynumber")); err {
+	case nil:
+		return ""
+	case errLockedOut:
+		return "本人確認に続けて失敗したため、しばらく投票できません"
+	case errRateLimited:
+		return "投票が多すぎます。しばらく待ってから投票してください"
+	default:
+		log.Printf("[%s] rate limiter: %s", getRequestID(c), err)
+		return ""
+	}
+}
+
+// checkedIdentity records the result of the identity check of POST /vote.
+// Only errNotFound is a failure: other errors of the UserStore say nothing about the identity,
+// and counting them would lock everyone out while the database is down.
+func (a *App) checkedIdentity(c *gin.Context, userErr error) {
+	if a.limiter == nil {
+		return
+	}
+	var err error
+	switch userErr {
+	case nil:
+		err = a.limiter.succeeded(c, c.PostForm("mynumber"))
+	case errNotFound:
+		err = a.limiter.failed(c, a.limiter.clientIP(c.Request), c.PostForm("mynumber"))
+	default:
+		return
+	}
+	if err != nil {
+		log.Printf("[%s] rate limiter: %s", getRequestID(c), err)
+	}
+}
+
+// MemoryLimiterStore implements LimiterStore in memory.
+// Full buckets and expired counts are removed once a minute.
+type MemoryLimiterStore struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	buckets   map[string]*tokenBucket
+	failures  map[string]*failureCount
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	// この時刻を過ぎると満杯なので消してよい
+	full time.Time
+}
+
+type failureCount struct {
+	count   int
+	expires time.Time
+}
+
+// NewMemoryLimiterStore returns an empty store
+func NewMemoryLimiterStore() *MemoryLimiterStore {
+	return &MemoryLimiterStore{
+		now:      time.Now,
+		buckets:  map[string]*tokenBucket{},
+		failures: map[string]*failureCount{},
+	}
+}
+
+// Take implements LimiterStore
+func (s *MemoryLimiterStore) Take(ctx context.Context, key string, rate float64, burst int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(burst), last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false, nil
+	}
+	b.tokens--
+	b.full = now.Add(time.Duration((float64(burst) - b.tokens) / rate * float64(time.Second)))
+	return true, nil
+}
+
+// AddFailure implements LimiterStore
+func (s *MemoryLimiterStore) AddFailure(ctx context.Context, key string, ttl time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.sweep(now)
+
+	f, ok := s.failures[key]
+	if !ok || !now.Before(f.expires) {
+		f = &failureCount{expires: now.Add(ttl)}
+		s.failures[key] = f
+	}
+	f.count++
+	return f.count, nil
+}
+
+// Failures implements LimiterStore
+func (s *MemoryLimiterStore) Failures(ctx context.Context, key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.failures[key]
+	if !ok || !s.now().Before(f.expires) {
+		return 0, nil
+	}
+	return f.count, nil
+}
+
+// ResetFailures implements LimiterStore
+func (s *MemoryLimiterStore) ResetFailures(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.failures, key)
+	return nil
+}
+
+func (s *MemoryLimiterStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+	for key, f := range s.failures {
+		if !now.Before(f.expires) {
+			delete(s.failures, key)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryLimiterStore()
+	s.now = func() time.Time { return now }
+
+	for i, want := range []bool{true, true, false} {
+		if ok, _ := s.Take(ctx, "a", 1, 2); ok != want {
+			t.Errorf("take %d = %v, want %v", i, ok, want)
+		}
+	}
+	if ok, _ := s.Take(ctx, "b", 1, 2); !ok {
+		t.Error("buckets are shared between keys")
+	}
+	now = now.Add(time.Second)
+	if ok, _ := s.Take(ctx, "a", 1, 2); !ok {
+		t.Error("the bucket is not refilled")
+	}
+
+	s.AddFailure(ctx, "a", time.Minute)
+	if n, _ := s.AddFailure(ctx, "a", time.Minute); n != 2 {
+		t.Errorf("failures = %d, want 2", n)
+	}
+	s.ResetFailures(ctx, "a")
+	if n, _ := s.Failures(ctx, "a"); n != 0 {
+		t.Errorf("failures after reset = %d, want 0", n)
+	}
+	s.AddFailure(ctx, "a", time.Minute)
+	now = now.Add(time.Minute)
+	if n, _ := s.Failures(ctx, "a"); n != 0 {
+		t.Errorf("failures after ttl = %d, want 0", n)
+	}
+
+	// 満杯のバケットと期限切れの数は消える
+	now = now.Add(time.Hour)
+	s.Take(ctx, "c", 1, 2)
+	if len(s.buckets) != 1 || len(s.failures) != 0 {
+		t.Errorf("%d buckets and %d failures are left after sweeping", len(s.buckets), len(s.failures))
+	}
+}
+
+// postVoteFrom sends POST /vote from a client connecting from ip without a proxy
+func postVoteFrom(app *App, ip string, form url.Values) *httptest.ResponseRecorder {
+	return postVoteVia(app, ip, nil, form)
+}
+
+func postVoteVia(app *App, remoteIP string, header http.Header, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/vote", strings.NewReader(form.Encode()))
+	req.RemoteAddr = net.JoinHostPort(remoteIP, "54321")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return serve(app, req)
+}
+
+func TestVoteRateLimit(t *testing.T) {
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+	app.limiter = NewRateLimiter(RateLimitConfig{IPRate: 0.001, IPBurst: 2}, NewMemoryLimiterStore())
+	vote := voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1")
+
+	for i := 0; i < 2; i++ {
+		if w := postVoteFrom(app, "192.0.2.1", vote); w.Code != http.StatusOK {
+			t.Fatalf("vote %d = %d, want 200", i, w.Code)
+		}
+	}
+	w := postVoteFrom(app, "192.0.2.1", vote)
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "投票が多すぎます") {
+		t.Errorf("vote over the limit = %d", w.Code)
+	}
+	if w := postVoteFrom(app, "192.0.2.2", vote); w.Code != http.StatusOK {
+		t.Errorf("vote from another IP = %d, want 200", w.Code)
+	}
+	if n := app.tally.VoteCount(1); n != 3 {
+		t.Errorf("votes = %d, want 3", n)
+	}
+}
+
+func TestVoteClientIP(t *testing.T) {
+	proxies, err := parseTrustedProxies("127.0.0.1, 10.0.0.0/8,::1")
+	if err != nil || len(proxies) != 3 {
+		t.Fatalf("parseTrustedProxies = %v, %v", proxies, err)
+	}
+	if _, err := parseTrustedProxies("nginx"); err == nil {
+		t.Error("a host name is accepted as a proxy")
+	}
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+	app.limiter = NewRateLimiter(RateLimitConfig{IPRate: 0.001, IPBurst: 1, TrustedProxies: proxies}, NewMemoryLimiterStore())
+	vote := voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1")
+
+	// nginx を通ると X-Real-IP で数え、クライアントが付けた X-Forwarded-For は見ない
+	for i, spoofed := range []string{"198.51.100.1", "198.51.100.2"} {
+		header := http.Header{"X-Real-Ip": {"192.0.2.1"}, "X-Forwarded-For": {spoofed}}
+		w := postVoteVia(app, "127.0.0.1", header, vote)
+		if want := []int{http.StatusOK, http.StatusTooManyRequests}[i]; w.Code != want {
+			t.Errorf("vote %d with X-Forwarded-For %s = %d, want %d", i, spoofed, w.Code, want)
+		}
+	}
+	if w := postVoteVia(app, "10.1.2.3", http.Header{"X-Real-Ip": {"192.0.2.2"}}, vote); w.Code != http.StatusOK {
+		t.Errorf("vote of another client via a trusted proxy = %d, want 200", w.Code)
+	}
+
+	// 直接繋いできたクライアントのヘッダは信用しない
+	for i, spoofed := range []string{"198.51.100.3", "198.51.100.4"} {
+		header := http.Header{"X-Real-Ip": {spoofed}, "X-Forwarded-For": {spoofed}}
+		w := postVoteVia(app, "192.0.2.3", header, vote)
+		if want := []int{http.StatusOK, http.StatusTooManyRequests}[i]; w.Code != want {
+			t.Errorf("direct vote %d with X-Real-IP %s = %d, want %d", i, spoofed, w.Code, want)
+		}
+	}
+}
+
+func TestVoteLockout(t *testing.T) {
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+	app.limiter = NewRateLimiter(RateLimitConfig{MaxFailures: 3, Lockout: time.Minute}, NewMemoryLimiterStore())
+	taro, hanako := testUsers[0], testUsers[1]
+	wrongName := voteForm(User{Name: "hoge", Address: taro.Address, MyNumber: taro.MyNumber}, "佐藤 一郎", "誠実さ", "1")
+
+	// 成功すると mynumber の失敗は数え直す
+	postVoteFrom(app, "192.0.2.1", wrongName)
+	postVoteFrom(app, "192.0.2.2", wrongName)
+	postVoteFrom(app, "192.0.2.3", voteForm(taro, "佐藤 一郎", "誠実さ", "1"))
+	postVoteFrom(app, "192.0.2.4", wrongName)
+	postVoteFrom(app, "192.0.2.5", wrongName)
+	if w := postVoteFrom(app, "192.0.2.6", voteForm(taro, "佐藤 一郎", "誠実さ", "1")); !strings.Contains(w.Body.String(), "投票に成功しました") {
+		t.Fatalf("locked out after a successful vote: %d", w.Code)
+	}
+
+	for i := 0; i < 3; i++ {
+		if w := postVoteFrom(app, "192.0.2.10", wrongName); !strings.Contains(w.Body.String(), "個人情報に誤りがあります") {
+			t.Fatalf("failure %d = %d", i, w.Code)
+		}
+	}
+	w := postVoteFrom(app, "192.0.2.11", voteForm(taro, "佐藤 一郎", "誠実さ", "1"))
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "本人確認に続けて失敗したため") {
+		t.Errorf("vote with a locked mynumber = %d", w.Code)
+	}
+	if w := postVoteFrom(app, "192.0.2.10", voteForm(hanako, "佐藤 一郎", "誠実さ", "1")); w.Code != http.StatusTooManyRequests {
+		t.Errorf("vote from a locked IP = %d, want 429", w.Code)
+	}
+	if w := postVoteFrom(app, "192.0.2.12", voteForm(hanako, "佐藤 一郎", "誠実さ", "1")); w.Code != http.StatusOK {
+		t.Errorf("vote of another user from another IP = %d, want 200", w.Code)
+	}
+}
+
+func TestVoteLockoutUserStoreError(t *testing.T) {
+	mem := NewMemoryStore(testUsers, testCandidates)
+	store := &brokenStore{MemoryStore: mem, users: true}
+	app := newTestApp(t, mem)
+	app.users = store
+	app.limiter = NewRateLimiter(RateLimitConfig{MaxFailures: 1, Lockout: time.Minute}, NewMemoryLimiterStore())
+	vote := voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1")
+
+	for i := 0; i < 2; i++ {
+		if w := postVoteFrom(app, "192.0.2.1", vote); w.Code != http.StatusInternalServerError {
+			t.Fatalf("vote %d while the users are down = %d, want 500", i, w.Code)
+		}
+	}
+	store.users = false
+	if w := postVoteFrom(app, "192.0.2.1", vote); w.Code != http.StatusOK {
+		t.Errorf("vote after the users are back = %d, want 200", w.Code)
+	}
+}
+
+// brokenLimiterStore fails like a shared backend that is down
+type brokenLimiterStore struct {
+	*MemoryLimiterStore
+}
+
+func (s brokenLimiterStore) Failures(ctx context.Context, key string) (int, error) {
+	return 0, errors.New("connection refused")
+}
+
+func TestVoteLimiterStoreError(t *testing.T) {
+	app := newTestApp(t, NewMemoryStore(testUsers, testCandidates))
+	app.limiter = NewRateLimiter(RateLimitConfig{MaxFailures: 1, Lockout: time.Minute}, brokenLimiterStore{NewMemoryLimiterStore()})
+
+	if w := postVoteFrom(app, "192.0.2.1", voteForm(testUsers[0], "佐藤 一郎", "誠実さ", "1")); w.Code != http.StatusOK {
+		t.Errorf("vote while the limiter store is down = %d, want 200", w.Code)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+)
+
+// IndexResult is the election result shown on GET /
+type IndexResult struct {
+	Candidates []CandidateElectionResult `json:"candidates"`
+	Parties    []PartyElectionResult     `json:"parties"`
+	SexRatio   map[string]int            `json:"sex_ratio"`
+}
+
+// CandidateResult is the election result shown on GET /candidates/:candidateID
+type CandidateResult struct {
+	Candidate Candidate `json:"candidate"`
+	Votes     int       `json:"votes"`
+	Keywords  []string  `json:"keywords"`
+}
+
+// PartyResult is the election result shown on GET /political_parties/:name
+type PartyResult struct {
+	PoliticalParty string      `json:"political_party"`
+	Votes          int         `json:"votes"`
+	Candidates     []Candidate `json:"candidates"`
+	Keywords       []string    `json:"keywords"`
+}
+
+func (a *App) getIndexResult(ctx context.Context) IndexResult {
+	electionResults := a.tally.ElectionResult()
+
+	// 上位10人と最下位のみ表示
+	candidates := electionResults
+	if len(electionResults) > 11 {
+		candidates = append(electionResults[:10:10], electionResults[len(electionResults)-1])
+	}
+
+	return IndexResult{
+		Candidates: candidates,
+		Parties:    a.tally.PartyResults(),
+		SexRatio:   a.tally.SexRatio(),
+	}
+}
+
+func (a *App) getCandidateResult(ctx context.Context, candidateID int) (CandidateResult, error) {
+	candidate, err := a.candidates.GetCandidate(ctx, candidateID)
+	if err != nil {
+		return CandidateResult{}, err
+	}
+	votes := a.tally.VoteCount(candidateID)
+	candidateIDs := []int{candidateID}
+	keywords := a.tally.Keywords(candidateIDs)
+
+	return CandidateResult{
+		Candidate: candidate,
+		Votes:     votes,
+		Keywords:  keywords,
+	}, nil
+}
+
+func (a *App) getPartyResult(ctx context.Context, partyName string) (PartyResult, error) {
+	votes := a.tally.PartyVotes(partyName)
+	candidates, err := a.candidates.GetCandidatesByPoliticalParty(ctx, partyName)
+	if err != nil {
+		return PartyResult{}, err
+	}
+	if len(candidates) == 0 {
+		return PartyResult{}, errNotFound
+	}
+	candidateIDs := []int{}
+	for _, c := range candidates {
+		candidateIDs = append(candidateIDs, c.ID)
+	}
+	keywords := a.tally.Keywords(candidateIDs)
+
+	return PartyResult{
+		PoliticalParty: partyName,
+		Votes:          votes,
+		Candidates:     candidates,
+		Keywords:       keywords,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRunServerWaitsForInFlightRequests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sock := filepath.Join(dir, "webapp.sock")
+
+	started := make(chan struct{})
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("voted"))
+	})
+	cfg := ServerConfig{Addr: "unix:" + sock, ReadTimeout: time.Second, WriteTimeout: time.Second, ShutdownTimeout: 5 * time.Second}
+	stop := make(chan os.Signal, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- runServer(h, cfg, stop)
+	}()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return net.Dial("unix", sock)
+		},
+	}}
+	var resp *http.Response
+	respErr := make(chan error, 1)
+	go func() {
+		// ソケットができるまで待つ
+		for i := 0; i < 50; i++ {
+			if _, err := os.Stat(sock); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		var err error
+		resp, err = client.Post("http://webapp/vote", "text/plain", nil)
+		respErr <- err
+	}()
+
+	select {
+	case <-started:
+	case err := <-done:
+		t.Fatalf("server stopped: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request did not reach the server")
+	}
+	stop <- syscall.SIGTERM
+
+	if err := <-respErr; err != nil {
+		t.Fatalf("in-flight request failed: %s", err)
+	}
+	defer resp.Body.Close()
+	if b, _ := ioutil.ReadAll(resp.Body); string(b) != "voted" {
+		t.Errorf("body = %q", b)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("runServer = %v", err)
+	}
+	if _, err := os.Stat(sock); !os.IsNotExist(err) {
+		t.Errorf("socket is left: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/gin-gonic/gin"
+)
+
+// セッションと、投票フォームの CSRF トークンのフィールド名
+const (
+	sessionName  = "showwin_happy"
+	csrfTokenKey = "csrf_token"
+)
+
+const minSessionKeyLength = 32
+
+// SessionConfig is the settings of the session cookie
+type SessionConfig struct {
+	// Keys sign the cookie. The first key signs new cookies, the others are only used to read
+	// cookies signed before the keys were rotated.
+	Keys [][]byte
+	// Secure sends the cookie only over https
+	Secure bool
+	// SameSite is "Lax", "Strict", "None" or "" not to set the attribute
+	SameSite string
+}
+
+// loadSessionKeys reads the keys from path, one per line, or else from secret separated by commas.
+// In both cases the current key is first. It returns nil if neither is set.
+func loadSessionKeys(path string, secret string) ([][]byte, error) {
+	var lines []string
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			lines = append(lines, s.Text())
+		}
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+	} else if secret != "" {
+		lines = strings.Split(secret, ",")
+	}
+
+	var keys [][]byte
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if len(line) < minSessionKeyLength {
+			return nil, fmt.Errorf("session key must be at least %d bytes", minSessionKeyLength)
+		}
+		keys = append(keys, []byte(line))
+	}
+	if len(keys) == 0 && len(lines) > 0 {
+		return nil, errors.New("no session key is found")
+	}
+	return keys, nil
+}
+
+// randomSessionKey is used when no key is configured. Sessions are lost when the process restarts.
+func randomSessionKey() []byte {
+	b := make([]byte, minSessionKeyLength)
+	rand.Read(b)
+	return b
+}
+
+func (cfg SessionConfig) store() sessions.Store {
+	var keyPairs [][]byte
+	for _, key := range cfg.Keys {
+		// 署名だけして暗号化はしない
+		keyPairs = append(keyPairs, key, nil)
+	}
+	store := sessions.NewCookieStore(keyPairs...)
+	store.Options(sessions.Options{Path: "/", HttpOnly: true, Secure: cfg.Secure})
+	return store
+}
+
+func validSameSite(mode string) bool {
+	switch mode {
+	case "", "Lax", "Strict", "None":
+		return true
+	}
+	return false
+}
+
+// sameSiteCookies adds the SameSite attribute to the cookies of the response.
+// The session library does not support SameSite, so the Set-Cookie headers are rewritten.
+func sameSiteCookies(mode string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if mode == "" {
+			c.Next()
+			return
+		}
+		w := &sameSiteWriter{ResponseWriter: c.Writer, attr: "; SameSite=" + mode}
+		c.Writer = w
+		c.Next()
+		// 本文の無いレスポンス (リダイレクトなど) は gin が最後にヘッダを書く
+		w.setSameSite()
+	}
+}
+
+type sameSiteWriter struct {
+	gin.ResponseWriter
+	attr string
+}
+
+func (w *sameSiteWriter) setSameSite() {
+	if w.Written() {
+		return
+	}
+	cookies := w.Header()["Set-Cookie"]
+	for i, cookie := range cookies {
+		if !strings.Contains(strings.ToLower(cookie), "samesite=") {
+			cookies[i] = cookie + w.attr
+		}
+	}
+}
+
+func (w *sameSiteWriter) WriteHeaderNow() {
+	w.setSameSite()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *sameSiteWriter) Write(b []byte) (int, error) {
+	w.setSameSite()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *sameSiteWriter) WriteString(s string) (int, error) {
+	w.setSameSite()
+	return w.ResponseWriter.WriteString(s)
+}
+
+// csrfToken returns the CSRF token of the session, saving a new one if the session has none
+func csrfToken(c *gin.Context) (string, error) {
+	session := sessions.Default(c)
+	if token, ok := session.Get(csrfTokenKey).(string); ok && token != "" {
+		return token, nil
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(b)
+	session.Set(csrfTokenKey, token)
+	return token, session.Save()
+}
+
+// validCSRFToken reports whether the csrf_token of the form is the token of the session
+func validCSRFToken(c *gin.Context) bool {
+	token, _ := sessions.Default(c).Get(csrfTokenKey).(string)
+	form := c.PostForm(csrfTokenKey)
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(form)) == 1
+}
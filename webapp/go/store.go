@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	errNotFound          = errors.New("not found")
+	errVoteLimitExceeded = errors.New("vote limit exceeded")
+	errNotLoaded         = errors.New("not loaded")
+)
+
+// UserStore finds voters
+type UserStore interface {
+	// GetUser returns errNotFound unless all of name, address and myNumber match
+	GetUser(ctx context.Context, name string, address string, myNumber string) (User, error)
+}
+
+// CandidateStore finds candidates
+type CandidateStore interface {
+	GetAllCandidate(ctx context.Context) ([]Candidate, error)
+	// GetCandidate and GetCandidateByName return errNotFound for unknown candidates
+	GetCandidate(ctx context.Context, candidateID int) (Candidate, error)
+	GetCandidateByName(ctx context.Context, name string) (Candidate, error)
+	GetCandidatesByPoliticalParty(ctx context.Context, party string) ([]Candidate, error)
+}
+
+// VoteStore reads and writes votes
+type VoteStore interface {
+	GetUserVotedCount(ctx context.Context, userID int) (int, error)
+	// CreateVotes writes voteCount votes at once. It returns errVoteLimitExceeded
+	// if the user would vote more than User.Votes in total.
+	CreateVotes(ctx context.Context, userID int, candidateID int, keyword string, voteCount int) error
+	// VoteCounts returns the sum of votes for each candidate and keyword
+	VoteCounts(ctx context.Context) ([]VoteCount, error)
+	DeleteAllVotes(ctx context.Context) error
+}
+
+// Pinger is implemented by stores that can check the connection to their backend
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// DBStatser is implemented by stores backed by a *sql.DB
+type DBStatser interface {
+	DBStats() sql.DBStats
+}
+
+// VoteCount is the number of votes for a candidate with a keyword
+type VoteCount struct {
+	CandidateID int
+	Keyword     string
+	Count       int
+}
+
+// MySQLStore implements UserStore, CandidateStore and VoteStore with the ishocon2 database
+type MySQLStore struct {
+	db *queryDB
+}
+
+// NewMySQLStore returns a store backed by db. Queries are counted in the QueryStats of the request.
+func NewMySQLStore(db *sql.DB) *MySQLStore {
+	return &MySQLStore{db: &queryDB{db}}
+}
+
+// Ping implements Pinger
+func (s *MySQLStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// DBStats implements DBStatser
+func (s *MySQLStore) DBStats() sql.DBStats {
+	return s.db.Stats()
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Tally keeps vote counts in memory. The VoteStore is the source of truth
+// and the tally is rebuilt from it by Load.
+type Tally struct {
+	// Load は書き込みを止めてから読み直す。投票は読み込みの前か後のどちらかに入るので、取りこぼしも二重計上もしない
+	writes     sync.RWMutex
+	mu         sync.RWMutex
+	candidates []Candidate
+	counts     map[int]int
+	keywords   map[int]map[string]int
+	loaded     bool
+}
+
+// Load rebuilds the tally from the stores. Record waits until it finishes.
+func (t *Tally) Load(ctx context.Context, candidateStore CandidateStore, voteStore VoteStore) error {
+	t.writes.Lock()
+	defer t.writes.Unlock()
+
+	candidates, err := candidateStore.GetAllCandidate(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].ID < candidates[j].ID })
+
+	voteCounts, err := voteStore.VoteCounts(ctx)
+	if err != nil {
+		return err
+	}
+	counts := map[int]int{}
+	keywords := map[int]map[string]int{}
+	for _, v := range voteCounts {
+		counts[v.CandidateID] += v.Count
+		if keywords[v.CandidateID] == nil {
+			keywords[v.CandidateID] = map[string]int{}
+		}
+		keywords[v.CandidateID][v.Keyword] += v.Count
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.candidates = candidates
+	t.counts = counts
+	t.keywords = keywords
+	t.loaded = true
+	return nil
+}
+
+// Loaded reports whether Load has succeeded at least once
+func (t *Tally) Loaded() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.loaded
+}
+
+// Record calls write, which writes the votes to the VoteStore, and counts them if it succeeds.
+// Load does not run in between, so the votes are counted exactly once.
+func (t *Tally) Record(write func() error, candidateID int, keyword string, n int) error {
+	t.writes.RLock()
+	defer t.writes.RUnlock()
+	if err := write(); err != nil {
+		return err
+	}
+	t.Add(candidateID, keyword, n)
+	return nil
+}
+
+// Add counts n votes for the candidate. Use Record to write the votes at the same time.
+func (t *Tally) Add(candidateID int, keyword string, n int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.counts == nil {
+		t.counts = map[int]int{}
+		t.keywords = map[int]map[string]int{}
+	}
+	t.counts[candidateID] += n
+	if t.keywords[candidateID] == nil {
+		t.keywords[candidateID] = map[string]int{}
+	}
+	t.keywords[candidateID][keyword] += n
+}
+
+// ElectionResult returns all candidates ordered by votes
+func (t *Tally) ElectionResult() []CandidateElectionResult {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	result := make([]CandidateElectionResult, 0, len(t.candidates))
+	for _, c := range t.candidates {
+		result = append(result, CandidateElectionResult{
+			ID:             c.ID,
+			Name:           c.Name,
+			PoliticalParty: c.PoliticalParty,
+			Sex:            c.Sex,
+			VoteCount:      t.counts[c.ID],
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].VoteCount > result[j].VoteCount })
+	return result
+}
+
+// VoteCount returns votes for the candidate
+func (t *Tally) VoteCount(candidateID int) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.counts[candidateID]
+}
+
+// PartyResults returns votes for every party ordered by votes
+func (t *Tally) PartyResults() []PartyElectionResult {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	partyResultMap := map[string]int{}
+	for _, c := range t.candidates {
+		partyResultMap[c.PoliticalParty] += t.counts[c.ID]
+	}
+	partyResults := []PartyElectionResult{}
+	for name, count := range partyResultMap {
+		partyResults = append(partyResults, PartyElectionResult{PoliticalParty: name, VoteCount: count})
+	}
+	sort.Slice(partyResults, func(i, j int) bool {
+		if partyResults[i].VoteCount == partyResults[j].VoteCount {
+			return partyResults[i].PoliticalParty < partyResults[j].PoliticalParty
+		}
+		return partyResults[i].VoteCount > partyResults[j].VoteCount
+	})
+	return partyResults
+}
+
+// PartyVotes returns votes for the party
+func (t *Tally) PartyVotes(party string) (votes int) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, c := range t.candidates {
+		if c.PoliticalParty == party {
+			votes += t.counts[c.ID]
+		}
+	}
+	return
+}
+
+// SexRatio returns votes for men and women candidates
+func (t *Tally) SexRatio() map[string]int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	sexRatio := map[string]int{
+		"men":   0,
+		"women": 0,
+	}
+	for _, c := range t.candidates {
+		if c.Sex == "男" {
+			sexRatio["men"] += t.counts[c.ID]
+		} else if c.Sex == "女" {
+			sexRatio["women"] += t.counts[c.ID]
+		}
+	}
+	return sexRatio
+}
+
+// Keywords returns the top 10 keywords of the candidates
+func (t *Tally) Keywords(candidateIDs []int) []string {
+	t.mu.RLock()
+	counts := map[string]int{}
+	for _, id := range candidateIDs {
+		for keyword, n := range t.keywords[id] {
+			counts[keyword] += n
+		}
+	}
+	t.mu.RUnlock()
+
+	keywords := make([]string, 0, len(counts))
+	for keyword := range counts {
+		keywords = append(keywords, keyword)
+	}
+	sort.Slice(keywords, func(i, j int) bool {
+		if counts[keywords[i]] == counts[keywords[j]] {
+			return keywords[i] < keywords[j]
+		}
+		return counts[keywords[i]] > counts[keywords[j]]
+	})
+	if len(keywords) > 10 {
+		keywords = keywords[:10]
+	}
+	return keywords
+}
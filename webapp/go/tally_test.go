@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowVoteStore takes time between reading the votes and returning them, as a large votes table does
+type slowVoteStore struct {
+	*MemoryStore
+}
+
+func (s slowVoteStore) VoteCounts(ctx context.Context) ([]VoteCount, error) {
+	counts, err := s.MemoryStore.VoteCounts(ctx)
+	time.Sleep(time.Millisecond)
+	return counts, err
+}
+
+// TestTallyReloadWhileVoting checks that no vote is lost or counted twice when the tally is reloaded during votes
+func TestTallyReloadWhileVoting(t *testing.T) {
+	var users []User
+	for id := 1; id <= 8; id++ {
+		users = append(users, User{ID: id, Name: "投票者 " + strconv.Itoa(id), Address: "東京都", MyNumber: strconv.Itoa(id), Votes: 1000})
+	}
+	store := NewMemoryStore(users, testCandidates)
+	app := newTestApp(t, store)
+	app.votes = slowVoteStore{store}
+	// Router は gin のグローバルな設定を書き換えるので、1度だけ作って共有する
+	router := app.Router()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for _, u := range users {
+		wg.Add(1)
+		go func(u User) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				req := httptest.NewRequest("POST", "/vote", strings.NewReader(voteForm(u, "佐藤 一郎", "誠実さ", "1").Encode()))
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				router.ServeHTTP(httptest.NewRecorder(), req)
+			}
+		}(u)
+	}
+	// t.Error は test が終わる前に呼ぶ必要があるので、reload の goroutine も待つ
+	var reloads sync.WaitGroup
+	reloads.Add(1)
+	go func() {
+		defer reloads.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			if err := app.reload(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	wg.Wait()
+	close(done)
+	reloads.Wait()
+
+	counts, _ := store.VoteCounts(context.Background())
+	want := 0
+	for _, c := range counts {
+		want += c.Count
+	}
+	if want != 400 {
+		t.Fatalf("%d votes are written, want 400", want)
+	}
+	if got := app.tally.VoteCount(1); got != want {
+		t.Errorf("tally has %d votes, the store has %d", got, want)
+	}
+}
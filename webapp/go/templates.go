@@ -0,0 +1,125 @@
+package main
+
+import (
+	"html/template"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin/render"
+)
+
+const layoutTemplate = "layout.tmpl"
+
+var templateFuncs = template.FuncMap{"indexPlus1": func(i int) int { return i + 1 }}
+
+// Templates parses layout.tmpl with each page template in dir once.
+// Pages are keyed by the file name without .tmpl, e.g. "index" for index.tmpl.
+// With reload, a page is parsed again when layout.tmpl or the page has been modified.
+type Templates struct {
+	dir    string
+	reload bool
+
+	mu    sync.RWMutex
+	pages map[string]*page
+}
+
+type page struct {
+	tmpl    *template.Template
+	modTime time.Time
+}
+
+// NewTemplates parses all pages in dir
+func NewTemplates(dir string, reload bool) (*Templates, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	t := &Templates{dir: dir, reload: reload, pages: map[string]*page{}}
+	for _, f := range files {
+		if filepath.Base(f) == layoutTemplate {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(f), ".tmpl")
+		p, err := t.parse(name)
+		if err != nil {
+			return nil, err
+		}
+		t.pages[name] = p
+	}
+	return t, nil
+}
+
+// Pages returns the number of parsed pages
+func (t *Templates) Pages() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.pages)
+}
+
+func (t *Templates) parse(name string) (*page, error) {
+	files := []string{filepath.Join(t.dir, layoutTemplate), filepath.Join(t.dir, name+".tmpl")}
+	modTime, err := latestModTime(files)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := template.New(name).Funcs(templateFuncs).ParseFiles(files...)
+	if err != nil {
+		return nil, err
+	}
+	return &page{tmpl: tmpl, modTime: modTime}, nil
+}
+
+func latestModTime(files []string) (latest time.Time, err error) {
+	for _, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return latest, err
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (t *Templates) lookup(name string) *template.Template {
+	t.mu.RLock()
+	p := t.pages[name]
+	t.mu.RUnlock()
+	if p == nil {
+		return nil
+	}
+	if !t.reload {
+		return p.tmpl
+	}
+
+	files := []string{filepath.Join(t.dir, layoutTemplate), filepath.Join(t.dir, name+".tmpl")}
+	modTime, err := latestModTime(files)
+	if err != nil || !modTime.After(p.modTime) {
+		return p.tmpl
+	}
+	// 編集途中で壊れたテンプレートは無視して前のものを使い続ける。
+	// 壊れたときの更新時刻を覚えておき、次に編集されるまでは読み直さない
+	reloaded, err := t.parse(name)
+	if err != nil {
+		log.Printf("template %s: %s", name, err)
+		reloaded = &page{tmpl: p.tmpl, modTime: modTime}
+	}
+	t.mu.Lock()
+	t.pages[name] = reloaded
+	t.mu.Unlock()
+	return reloaded.tmpl
+}
+
+// Instance implements render.HTMLRender. It renders the "base" template of the page.
+func (t *Templates) Instance(name string, data interface{}) render.Render {
+	tmpl := t.lookup(name)
+	if tmpl == nil {
+		panic("template: no page " + name)
+	}
+	return render.HTML{Template: tmpl, Name: "base", Data: data}
+}
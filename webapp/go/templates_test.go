@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func renderPage(t *testing.T, templates *Templates, name string) string {
+	var b bytes.Buffer
+	if err := templates.lookup(name).ExecuteTemplate(&b, "base", nil); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestTemplatesReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	write := func(name, src string, modTime time.Time) {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Add(-time.Hour)
+	write("layout.tmpl", `{{ define "base" }}[{{ template "content" . }}]{{ end }}`, start)
+	write("page.tmpl", `{{ define "content" }}v1{{ end }}`, start)
+
+	for _, reload := range []bool{false, true} {
+		write("page.tmpl", `{{ define "content" }}v1{{ end }}`, start)
+		templates, err := NewTemplates(dir, reload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := renderPage(t, templates, "page"); got != "[v1]" {
+			t.Fatalf("reload=%v: got %q", reload, got)
+		}
+
+		write("page.tmpl", `{{ define "content" }}v2{{ end }}`, start.Add(time.Minute))
+		want := "[v1]"
+		if reload {
+			want = "[v2]"
+		}
+		if got := renderPage(t, templates, "page"); got != want {
+			t.Errorf("reload=%v: got %q, want %q", reload, got, want)
+		}
+
+		// 壊れたテンプレートは無視され、編集ごとに1度だけ読み直してログに出す
+		var logs bytes.Buffer
+		log.SetOutput(&logs)
+		write("page.tmpl", `{{ define "content" }}{{ end`, start.Add(2*time.Minute))
+		for i := 0; i < 3; i++ {
+			if got := renderPage(t, templates, "page"); got != want {
+				t.Errorf("reload=%v: got %q after a broken edit, want %q", reload, got, want)
+			}
+		}
+		log.SetOutput(os.Stderr)
+		wantLogs := 0
+		if reload {
+			wantLogs = 1
+		}
+		if n := strings.Count(logs.String(), "template page:"); n != wantLogs {
+			t.Errorf("reload=%v: the broken edit is logged %d times, want %d", reload, n, wantLogs)
+		}
+
+		if reload {
+			write("page.tmpl", `{{ define "content" }}v3{{ end }}`, start.Add(3*time.Minute))
+			if got := renderPage(t, templates, "page"); got != "[v3]" {
+				t.Errorf("got %q after fixing the template, want %q", got, "[v3]")
+			}
+		}
+	}
+}
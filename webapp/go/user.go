@@ -1,6 +1,9 @@
 package main
 
-import "context"
+import (
+	"context"
+	"database/sql"
+)
 
 // User Model
 type User struct {
@@ -11,9 +14,13 @@ type User struct {
 	Votes    int
 }
 
-func getUser(ctx context.Context, name string, address string, myNumber string) (user User, err error) {
-	row := db.QueryRowContext(ctx, "SELECT * FROM users WHERE name = ? AND address = ? AND mynumber = ?",
+// GetUser implements UserStore
+func (s *MySQLStore) GetUser(ctx context.Context, name string, address string, myNumber string) (user User, err error) {
+	row := s.db.QueryRowContext(ctx, "SELECT * FROM users WHERE name = ? AND address = ? AND mynumber = ?",
 		name, address, myNumber)
 	err = row.Scan(&user.ID, &user.Name, &user.Address, &user.MyNumber, &user.Votes)
+	if err == sql.ErrNoRows {
+		err = errNotFound
+	}
 	return
 }
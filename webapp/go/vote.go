@@ -1,8 +1,8 @@
 package main
 
 import (
-	"strings"
 	"context"
+	"database/sql"
 )
 
 // Vote Model
@@ -11,49 +11,76 @@ type Vote struct {
 	UserID      int
 	CandidateID int
 	Keyword     string
+	Count       int
 }
 
-func getVoteCountByCandidateID(ctx context.Context, candidateID int) (count int) {
-	row := db.QueryRowContext(ctx, "SELECT COUNT(*) AS count FROM votes WHERE candidate_id = ?", candidateID)
-	row.Scan(&count)
+// GetUserVotedCount implements VoteStore
+func (s *MySQLStore) GetUserVotedCount(ctx context.Context, userID int) (count int, err error) {
+	row := s.db.QueryRowContext(ctx, "SELECT IFNULL(SUM(`count`), 0) AS count FROM votes WHERE user_id = ?", userID)
+	err = row.Scan(&count)
 	return
 }
 
-func getUserVotedCount(ctx context.Context, userID int) (count int) {
-	row := db.QueryRowContext(ctx, "SELECT COUNT(*) AS count FROM votes WHERE user_id = ?", userID)
-	row.Scan(&count)
-	return
-}
+// CreateVotes implements VoteStore. voteCount 票を1行で書き込む。
+// ユーザの行をロックしてから投票済みの数を数えるので、同じユーザの同時投票でも上限を超えない。
+func (s *MySQLStore) CreateVotes(ctx context.Context, userID int, candidateID int, keyword string, voteCount int) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var maxVotes, votedCount int
+	err = tx.QueryRowContext(ctx, "SELECT votes FROM users WHERE id = ? FOR UPDATE", userID).Scan(&maxVotes)
+	if err == sql.ErrNoRows {
+		return errNotFound
+	}
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowContext(ctx, "SELECT IFNULL(SUM(`count`), 0) FROM votes WHERE user_id = ?", userID).Scan(&votedCount)
+	if err != nil {
+		return err
+	}
+	if maxVotes < votedCount+voteCount {
+		return errVoteLimitExceeded
+	}
 
-func createVote(ctx context.Context, userID int, candidateID int, keyword string) {
-	db.ExecContext(ctx, "INSERT INTO votes (user_id, candidate_id, keyword) VALUES (?, ?, ?)",
-		userID, candidateID, keyword)
+	if voteCount > 0 {
+		_, err = tx.ExecContext(ctx, "INSERT INTO votes (user_id, candidate_id, keyword, `count`) VALUES (?, ?, ?, ?)",
+			userID, candidateID, keyword, voteCount)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-func getVoiceOfSupporter(ctx context.Context, candidateIDs []int) (voices []string) {
-	args := []interface{}{}
-	for _, candidateID := range candidateIDs {
-		args = append(args, candidateID)
-	}
-	rows, err := db.QueryContext(ctx, `
-    SELECT keyword
-    FROM votes
-    WHERE candidate_id IN (`+ strings.Join(strings.Split(strings.Repeat("?", len(candidateIDs)), ""), ",")+ `)
-    GROUP BY keyword
-    ORDER BY COUNT(*) DESC
-    LIMIT 10`, args...)
+// VoteCounts implements VoteStore
+func (s *MySQLStore) VoteCounts(ctx context.Context) ([]VoteCount, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT candidate_id, keyword, SUM(`count`) FROM votes GROUP BY candidate_id, keyword")
 	if err != nil {
-		return nil
+		return nil, err
 	}
-
 	defer rows.Close()
+
+	counts := []VoteCount{}
 	for rows.Next() {
-		var keyword string
-		err = rows.Scan(&keyword)
-		if err != nil {
-			panic(err.Error())
+		var v VoteCount
+		if err := rows.Scan(&v.CandidateID, &v.Keyword, &v.Count); err != nil {
+			return nil, err
 		}
-		voices = append(voices, keyword)
+		counts = append(counts, v)
 	}
-	return
+	return counts, rows.Err()
+}
+
+// DeleteAllVotes implements VoteStore
+func (s *MySQLStore) DeleteAllVotes(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM votes")
+	return err
 }